@@ -0,0 +1,34 @@
+package api
+
+// ThingAction describes an action available on a thing, in the openHAB API
+type ThingAction struct {
+	ActionUID   string         `json:"actionUid"`
+	Label       string         `json:"label"`
+	Description string         `json:"description"`
+	Inputs      []ActionInput  `json:"inputs"`
+	Outputs     []ActionOutput `json:"outputs"`
+	Visibility  string         `json:"visibility"`
+	Tags        []string       `json:"tags"`
+}
+
+// ActionInput describes an input parameter of a thing action
+type ActionInput struct {
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Label        string   `json:"label"`
+	Description  string   `json:"description"`
+	Required     bool     `json:"required"`
+	Tags         []string `json:"tags"`
+	Reference    string   `json:"reference"`
+	DefaultValue string   `json:"defaultValue"`
+}
+
+// ActionOutput describes a value returned by a thing action
+type ActionOutput struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Reference   string   `json:"reference"`
+}
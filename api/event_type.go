@@ -18,7 +18,7 @@ const (
 	EventThingStatusInfoChanged = "ThingStatusInfoChangedEvent" // The status of a thing changed.
 	EventInboxAdded             = "InboxAddedEvent"             // A discovery result has been added to the inbox.
 	EventInboxRemoved           = "InboxRemovedEvent"           // A discovery result has been removed from the inbox.
-	EventInboxUpdate            = "InboxUpdateEvent"            // A discovery result has been updated in the inbox.
+	EventInboxUpdated           = "InboxUpdatedEvent"           // A discovery result has been updated in the inbox.
 	EventItemChannelLinkAdded   = "ItemChannelLinkAddedEvent"   // An item channel link has been added to the registry.
 	EventItemChannelLinkRemoved = "ItemChannelLinkRemovedEvent" // An item channel link has been removed from the registry.
 	EventChannelTriggered       = "ChannelTriggeredEvent"       // A channel has been triggered.
@@ -27,10 +27,15 @@ const (
 	EventTypeStartlevel = "StartlevelEvent" // Event sent during server startup (typically from 30 to 100)
 )
 
+// EventInboxUpdate was the wrong name of the inbox updated event.
+//
+// Deprecated: use EventInboxUpdated instead.
+const EventInboxUpdate = EventInboxUpdated
+
 const (
 	TopicEventAdded          = "added"          // item, thing, inbox, link
 	TopicEventRemoved        = "removed"        // item, thing, inbox, link
-	TopicEventUpdated        = "updated"        // item, thing, inbox
+	TopicEventUpdated        = "updated"        // item, thing, inbox, sitemap widget
 	TopicEventCommand        = "command"        // item
 	TopicEventState          = "state"          // item
 	TopicEventStateUpdated   = "stateupdated"   // item
@@ -39,4 +44,5 @@ const (
 	TopicEventStatus         = "status"         // thing
 	TopicEventStatusChanged  = "statuschanged"  // thing
 	TopicEventTriggered      = "triggered"      // channel
+	TopicEventChanged        = "changed"        // sitemap
 )
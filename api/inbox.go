@@ -0,0 +1,17 @@
+package api
+
+// DiscoveryResult structure in the openHAB API (an entry of the inbox)
+type DiscoveryResult struct {
+	ThingUID               string         `json:"thingUID"`
+	ThingTypeUID           string         `json:"thingTypeUID"`
+	BridgeUID              string         `json:"bridgeUID,omitempty"`
+	Flag                   string         `json:"flag"`
+	Label                  string         `json:"label"`
+	Properties             map[string]any `json:"properties"`
+	RepresentationProperty string         `json:"representationProperty,omitempty"`
+}
+
+const (
+	DiscoveryFlagNew     = "NEW"
+	DiscoveryFlagIgnored = "IGNORED"
+)
@@ -16,7 +16,28 @@ type Item struct {
 	Function           *Function           `json:"function,omitempty"`
 	StateDescription   *StateDescription   `json:"stateDescription,omitempty"`
 	CommandDescription *CommandDescription `json:"commandDescription,omitempty"`
-	Members            []*Item             `json:"members,omitempty"` // this field is only populated with ?recursive=true parameter
+	Members            []*Item             `json:"members,omitempty"`  // this field is only populated with ?recursive=true parameter
+	Metadata           map[string]Metadata `json:"metadata,omitempty"` // this field is only populated with ?metadata= parameter
+}
+
+// Metadata of an item in a namespace (like "expire", "alexa", "homekit" or any custom namespace)
+type Metadata struct {
+	Value  string         `json:"value"`
+	Config map[string]any `json:"config,omitempty"`
+}
+
+// Statuses of BulkItemResult
+const (
+	BulkItemCreated = "created"
+	BulkItemUpdated = "updated"
+	BulkItemError   = "error"
+)
+
+// BulkItemResult is the result for one item when adding or updating a list of items
+type BulkItemResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
 }
 
 type Function struct {
@@ -25,9 +46,9 @@ type Function struct {
 }
 
 type StateDescription struct {
-	Minimum  int            `json:"minimum"`
-	Maximum  int            `json:"maximum"`
-	Step     int            `json:"step"`
+	Minimum  float64        `json:"minimum"`
+	Maximum  float64        `json:"maximum"`
+	Step     float64        `json:"step"`
 	Pattern  string         `json:"pattern"`
 	ReadOnly bool           `json:"readOnly"`
 	Options  []StateOptions `json:"options"`
@@ -0,0 +1,14 @@
+package api
+
+// ItemChannelLink structure in the openHAB API
+type ItemChannelLink struct {
+	ItemName      string         `json:"itemName"`
+	ChannelUID    string         `json:"channelUID"`
+	Configuration map[string]any `json:"configuration"`
+	Editable      bool           `json:"editable,omitempty"`
+}
+
+const (
+	// LinkConfigurationProfile is the key of the profile in the link configuration (like "system:default" or "system:follow")
+	LinkConfigurationProfile = "profile"
+)
@@ -0,0 +1,27 @@
+package api
+
+// PersistenceService structure in the openHAB API
+type PersistenceService struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+}
+
+const (
+	PersistenceServiceQueryable  = "Queryable"
+	PersistenceServiceModifiable = "Modifiable"
+)
+
+// ItemHistory structure in the openHAB API: the persisted states of an item
+type ItemHistory struct {
+	Name         string            `json:"name"`
+	TotalRecords string            `json:"totalrecords,omitempty"`
+	DataPoints   string            `json:"datapoints,omitempty"`
+	Data         []HistoryDataBean `json:"data"`
+}
+
+// HistoryDataBean structure in the openHAB API: a persisted state at a time (in milliseconds since epoch)
+type HistoryDataBean struct {
+	Time  int64  `json:"time"`
+	State string `json:"state"`
+}
@@ -0,0 +1,86 @@
+package api
+
+// Sitemap structure in the openHAB API
+type Sitemap struct {
+	Name     string       `json:"name"`
+	Label    string       `json:"label"`
+	Link     string       `json:"link"`
+	Homepage *SitemapPage `json:"homepage,omitempty"`
+}
+
+// SitemapPage structure in the openHAB API. The page ID of the homepage is the name of the sitemap.
+type SitemapPage struct {
+	ID      string          `json:"id"`
+	Title   string          `json:"title"`
+	Icon    string          `json:"icon,omitempty"`
+	Link    string          `json:"link"`
+	Parent  *SitemapPage    `json:"parent,omitempty"`
+	Leaf    bool            `json:"leaf"`
+	Timeout bool            `json:"timeout"`
+	Widgets []SitemapWidget `json:"widgets"`
+}
+
+// SitemapWidget structure in the openHAB API
+type SitemapWidget struct {
+	WidgetID   string           `json:"widgetId"`
+	Type       string           `json:"type"`
+	Visibility bool             `json:"visibility"`
+	Label      string           `json:"label"`
+	Icon       string           `json:"icon,omitempty"`
+	LabelColor string           `json:"labelcolor,omitempty"`
+	ValueColor string           `json:"valuecolor,omitempty"`
+	IconColor  string           `json:"iconcolor,omitempty"`
+	Pattern    string           `json:"pattern,omitempty"`
+	State      string           `json:"state,omitempty"`
+	Mappings   []SitemapMapping `json:"mappings,omitempty"`
+	MinValue   float64          `json:"minValue,omitempty"`
+	MaxValue   float64          `json:"maxValue,omitempty"`
+	Step       float64          `json:"step,omitempty"`
+	URL        string           `json:"url,omitempty"`
+	Refresh    int              `json:"refresh,omitempty"`
+	Height     int              `json:"height,omitempty"`
+	Item       *Item            `json:"item,omitempty"`
+	LinkedPage *SitemapPage     `json:"linkedPage,omitempty"`
+	Widgets    []SitemapWidget  `json:"widgets"`
+}
+
+// SitemapMapping structure in the openHAB API: a command sent by a button of a widget
+type SitemapMapping struct {
+	Command string `json:"command"`
+	Label   string `json:"label"`
+	Icon    string `json:"icon,omitempty"`
+}
+
+// SitemapEvent structure in the openHAB API: an event received from a sitemap subscription.
+// The Type is empty for a widget event.
+type SitemapEvent struct {
+	Type               string `json:"TYPE,omitempty"`
+	SitemapName        string `json:"sitemapName"`
+	PageID             string `json:"pageId"`
+	WidgetID           string `json:"widgetId,omitempty"`
+	Label              string `json:"label,omitempty"`
+	Icon               string `json:"icon,omitempty"`
+	LabelColor         string `json:"labelcolor,omitempty"`
+	ValueColor         string `json:"valuecolor,omitempty"`
+	IconColor          string `json:"iconcolor,omitempty"`
+	ReloadIcon         bool   `json:"reloadIcon,omitempty"`
+	Visibility         bool   `json:"visibility"`
+	DescriptionChanged bool   `json:"descriptionChanged,omitempty"`
+	State              string `json:"state,omitempty"`
+	Item               *Item  `json:"item,omitempty"`
+}
+
+const (
+	SitemapEventAlive   = "ALIVE"           // sent regularly to keep the subscription alive
+	SitemapEventChanged = "SITEMAP_CHANGED" // the sitemap definition changed: the page should be reloaded
+)
+
+// SitemapSubscription structure in the openHAB API: the response of a new subscription to sitemap events
+type SitemapSubscription struct {
+	Status  string `json:"status"`
+	Context struct {
+		Headers struct {
+			Location []string `json:"Location"`
+		} `json:"headers"`
+	} `json:"context"`
+}
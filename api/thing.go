@@ -9,6 +9,7 @@ type Thing struct {
 	Configuration map[string]any    `json:"configuration"`
 	Properties    map[string]string `json:"properties"`
 	ThingTypeUID  string            `json:"thingTypeUID"`
+	Editable      bool              `json:"editable"`
 }
 
 type ThingStatusInfo struct {
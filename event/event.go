@@ -50,6 +50,12 @@ func New(data string) (Event, error) {
 	case api.EventItemUpdated:
 		return newEventItemUpdated(message)
 
+	case api.EventThingAdded:
+		return newEventThingAdded(message)
+
+	case api.EventThingRemoved:
+		return newEventThingRemoved(message)
+
 	case api.EventThingUpdated:
 		return newEventThingUpdated(message)
 
@@ -59,6 +65,21 @@ func New(data string) (Event, error) {
 	case api.EventThingStatusInfoChanged:
 		return newEventThingStatusInfoChanged(message)
 
+	case api.EventInboxAdded:
+		return newEventInbox(message, func(result DiscoveryResult) Event { return NewInboxAdded(result) })
+
+	case api.EventInboxRemoved:
+		return newEventInbox(message, func(result DiscoveryResult) Event { return NewInboxRemoved(result) })
+
+	case api.EventInboxUpdated:
+		return newEventInbox(message, func(result DiscoveryResult) Event { return NewInboxUpdated(result) })
+
+	case api.EventItemChannelLinkAdded:
+		return newEventItemChannelLink(message, func(link Link) Event { return NewItemChannelLinkAdded(link) })
+
+	case api.EventItemChannelLinkRemoved:
+		return newEventItemChannelLink(message, func(link Link) Event { return NewItemChannelLinkRemoved(link) })
+
 	case api.EventTypeAlive:
 		return NewAliveEvent(), nil
 
@@ -231,6 +252,38 @@ func newEventItemUpdated(message api.EventMessage) (Event, error) {
 	), nil
 }
 
+func newEventThingAdded(message api.EventMessage) (Event, error) {
+	data := api.Thing{}
+	err := json.Unmarshal([]byte(message.Payload), &data)
+	if err != nil {
+		return nil, errDecodingMessage(err)
+	}
+	return NewThingAdded(Thing{
+		UID:           data.UID,
+		Label:         data.Label,
+		BridgeUID:     data.BridgeUID,
+		Configuration: data.Configuration,
+		Properties:    data.Properties,
+		ThingTypeUID:  data.ThingTypeUID,
+	}), nil
+}
+
+func newEventThingRemoved(message api.EventMessage) (Event, error) {
+	data := api.Thing{}
+	err := json.Unmarshal([]byte(message.Payload), &data)
+	if err != nil {
+		return nil, errDecodingMessage(err)
+	}
+	return NewThingRemoved(Thing{
+		UID:           data.UID,
+		Label:         data.Label,
+		BridgeUID:     data.BridgeUID,
+		Configuration: data.Configuration,
+		Properties:    data.Properties,
+		ThingTypeUID:  data.ThingTypeUID,
+	}), nil
+}
+
 func newEventThingUpdated(message api.EventMessage) (Event, error) {
 	data := make([]api.Thing, 2)
 	err := json.Unmarshal([]byte(message.Payload), &data)
@@ -270,6 +323,7 @@ func newEventThingStatusInfo(message api.EventMessage) (Event, error) {
 	return NewThingStatusInfoEvent(thingName, ThingStatus{
 		Status:       data.Status,
 		StatusDetail: data.StatusDetail,
+		Description:  data.Description,
 	}), nil
 }
 
@@ -296,6 +350,36 @@ func newEventThingStatusInfoChanged(message api.EventMessage) (Event, error) {
 		}), nil
 }
 
+func newEventInbox(message api.EventMessage, newEvent func(result DiscoveryResult) Event) (Event, error) {
+	data := api.DiscoveryResult{}
+	err := json.Unmarshal([]byte(message.Payload), &data)
+	if err != nil {
+		return nil, errDecodingMessage(err)
+	}
+	return newEvent(DiscoveryResult{
+		ThingUID:               data.ThingUID,
+		ThingTypeUID:           data.ThingTypeUID,
+		BridgeUID:              data.BridgeUID,
+		Flag:                   data.Flag,
+		Label:                  data.Label,
+		Properties:             data.Properties,
+		RepresentationProperty: data.RepresentationProperty,
+	}), nil
+}
+
+func newEventItemChannelLink(message api.EventMessage, newEvent func(link Link) Event) (Event, error) {
+	data := api.ItemChannelLink{}
+	err := json.Unmarshal([]byte(message.Payload), &data)
+	if err != nil {
+		return nil, errDecodingMessage(err)
+	}
+	return newEvent(Link{
+		ItemName:      data.ItemName,
+		ChannelUID:    data.ChannelUID,
+		Configuration: data.Configuration,
+	}), nil
+}
+
 func newEventChannelTriggered(message api.EventMessage) (Event, error) {
 	data := api.EventTriggered{}
 	err := json.Unmarshal([]byte(message.Payload), &data)
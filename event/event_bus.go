@@ -32,6 +32,7 @@ func NewEventBus(async bool) *eventBus {
 // Subscribe returns an id for when you need to un-subscribe.
 //
 // name is the name of the item/thing/channel you want to follow.
+// It can also be a glob pattern or a regular expression (see Pattern).
 // eventType is the type of event you want to follow.
 // callback function is called when a matching event occurs.
 func (b *eventBus) Subscribe(name string, eventType Type, callback func(e Event)) int {
@@ -41,6 +42,7 @@ func (b *eventBus) Subscribe(name string, eventType Type, callback func(e Event)
 // SubscribeOnce can only receive one event.
 //
 // name is the name of the item/thing/channel you want to follow.
+// It can also be a glob pattern or a regular expression (see Pattern).
 // eventType is the type of event you want to follow.
 // callback function is called when a matching event occurs.
 func (b *eventBus) SubscribeOnce(name string, eventType Type, callback func(e Event)) int {
@@ -55,6 +57,7 @@ func (b *eventBus) subscribe(name string, eventType Type, once bool, callback fu
 	sub := subscription{
 		id:        b.subIDCount,
 		name:      name,
+		pattern:   NewPattern(name),
 		eventType: eventType,
 		callback:  callback,
 		once:      once,
@@ -107,7 +110,7 @@ func (b *eventBus) Publish(event Event) int {
 		if sub.eventType != event.Type() {
 			continue
 		}
-		if sub.name == "" || sub.eventType.Match(event.Topic(), sub.name) {
+		if sub.name == "" || sub.eventType.MatchPattern(event.Topic(), sub.pattern) {
 			if sub.once {
 				unsubscribed = append(unsubscribed, sub.id)
 			}
@@ -352,3 +352,18 @@ func TestSubscribeOnceAsync(t *testing.T) {
 	eventBus.Wait()
 	assert.Equal(t, int32(2), atomic.LoadInt32(&call))
 }
+
+func TestSubscribeWithPattern(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	eventBus := NewEventBus(false)
+
+	eventBus.Subscribe("Temperature_*", TypeItemStateChanged, func(e Event) {
+		calls++
+	})
+
+	assert.Equal(t, 1, eventBus.Publish(NewItemStateChanged("Temperature_Kitchen", "Decimal", "20", "Decimal", "21")))
+	assert.Equal(t, 1, eventBus.Publish(NewItemStateChanged("Temperature_Lounge", "Decimal", "20", "Decimal", "21")))
+	assert.Equal(t, 0, eventBus.Publish(NewItemStateChanged("Humidity_Kitchen", "Decimal", "50", "Decimal", "51")))
+	assert.Equal(t, 2, calls)
+}
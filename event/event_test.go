@@ -122,8 +122,34 @@ func TestCreateEventFromJSON(t *testing.T) {
 				Thing:    Thing{UID: "zwave:device:c4dcc784:node8", Label: "RaZberry 2 controller", BridgeUID: "zwave:serial_zstick:c4dcc784", Configuration: map[string]interface{}{"node_id": float64(8)}, Properties: map[string]string{"zwave_beaming": "true", "zwave_class_basic": "BASIC_TYPE_STATIC_CONTROLLER", "zwave_class_generic": "GENERIC_TYPE_STATIC_CONTROLLER", "zwave_class_specific": "SPECIFIC_TYPE_GATEWAY", "zwave_frequent": "false", "zwave_lastheal": "2022-12-10T01:27:54Z", "zwave_listening": "true", "zwave_neighbours": "1,6", "zwave_nodeid": "8", "zwave_plus_devicetype": "NODE_TYPE_ZWAVEPLUS_NODE", "zwave_plus_roletype": "ROLE_TYPE_CONTROLLER_CENTRAL_STATIC", "zwave_routing": "true", "zwave_secure": "false", "zwave_version": "0.0"}, ThingTypeUID: "zwave:device"},
 			},
 		},
-		// {`{"topic":"smarthome/links/Presence_Mobile_Fred-network:pingdevice:3aadd7c9:online/added","payload":"{\"channelUID\":\"network:pingdevice:3aadd7c9:online\",\"configuration\":{\"profile\":\"system:default\"},\"itemName\":\"Presence_Mobile_Fred\"}","type":"ItemChannelLinkAddedEvent"}`,
-		// }
+		{
+			`{"topic":"openhab/things/astro:sun:local/added","payload":"{\"label\":\"Local Sun\",\"configuration\":{\"interval\":300,\"geolocation\":\"51.5,-0.1\"},\"properties\":{},\"UID\":\"astro:sun:local\",\"thingTypeUID\":\"astro:sun\",\"channels\":[]}","type":"ThingAddedEvent"}`,
+			ThingAdded{topic: "things/astro:sun:local/added", Thing: Thing{UID: "astro:sun:local", Label: "Local Sun", Configuration: map[string]interface{}{"interval": float64(300), "geolocation": "51.5,-0.1"}, Properties: map[string]string{}, ThingTypeUID: "astro:sun"}},
+		},
+		{
+			`{"topic":"openhab/things/astro:sun:local/removed","payload":"{\"label\":\"Local Sun\",\"configuration\":{},\"properties\":{},\"UID\":\"astro:sun:local\",\"thingTypeUID\":\"astro:sun\",\"channels\":[]}","type":"ThingRemovedEvent"}`,
+			ThingRemoved{topic: "things/astro:sun:local/removed", Thing: Thing{UID: "astro:sun:local", Label: "Local Sun", Configuration: map[string]interface{}{}, Properties: map[string]string{}, ThingTypeUID: "astro:sun"}},
+		},
+		{
+			`{"topic":"openhab/inbox/hue:0220:001788a1b2c3:5/added","payload":"{\"flag\":\"NEW\",\"label\":\"Hue color lamp\",\"properties\":{\"uniqueId\":\"00:17:88:01:00:bd:c7:b9-0b\"},\"representationProperty\":\"uniqueId\",\"thingUID\":\"hue:0220:001788a1b2c3:5\",\"thingTypeUID\":\"hue:0220\",\"bridgeUID\":\"hue:bridge:001788a1b2c3\"}","type":"InboxAddedEvent"}`,
+			InboxAdded{topic: "inbox/hue:0220:001788a1b2c3:5/added", DiscoveryResult: DiscoveryResult{ThingUID: "hue:0220:001788a1b2c3:5", ThingTypeUID: "hue:0220", BridgeUID: "hue:bridge:001788a1b2c3", Flag: "NEW", Label: "Hue color lamp", Properties: map[string]any{"uniqueId": "00:17:88:01:00:bd:c7:b9-0b"}, RepresentationProperty: "uniqueId"}},
+		},
+		{
+			`{"topic":"openhab/inbox/hue:0220:001788a1b2c3:5/updated","payload":"{\"flag\":\"IGNORED\",\"label\":\"Hue color lamp\",\"properties\":{},\"thingUID\":\"hue:0220:001788a1b2c3:5\",\"thingTypeUID\":\"hue:0220\"}","type":"InboxUpdatedEvent"}`,
+			InboxUpdated{topic: "inbox/hue:0220:001788a1b2c3:5/updated", DiscoveryResult: DiscoveryResult{ThingUID: "hue:0220:001788a1b2c3:5", ThingTypeUID: "hue:0220", Flag: "IGNORED", Label: "Hue color lamp", Properties: map[string]any{}}},
+		},
+		{
+			`{"topic":"openhab/inbox/hue:0220:001788a1b2c3:5/removed","payload":"{\"flag\":\"NEW\",\"label\":\"Hue color lamp\",\"properties\":{},\"thingUID\":\"hue:0220:001788a1b2c3:5\",\"thingTypeUID\":\"hue:0220\"}","type":"InboxRemovedEvent"}`,
+			InboxRemoved{topic: "inbox/hue:0220:001788a1b2c3:5/removed", DiscoveryResult: DiscoveryResult{ThingUID: "hue:0220:001788a1b2c3:5", ThingTypeUID: "hue:0220", Flag: "NEW", Label: "Hue color lamp", Properties: map[string]any{}}},
+		},
+		{
+			`{"topic":"smarthome/links/Presence_Mobile_Fred-network:pingdevice:3aadd7c9:online/added","payload":"{\"channelUID\":\"network:pingdevice:3aadd7c9:online\",\"configuration\":{\"profile\":\"system:default\"},\"itemName\":\"Presence_Mobile_Fred\"}","type":"ItemChannelLinkAddedEvent"}`,
+			ItemChannelLinkAdded{topic: "links/Presence_Mobile_Fred-network:pingdevice:3aadd7c9:online/added", Link: Link{ItemName: "Presence_Mobile_Fred", ChannelUID: "network:pingdevice:3aadd7c9:online", Configuration: map[string]any{"profile": "system:default"}}},
+		},
+		{
+			`{"topic":"openhab/links/Presence_Mobile_Fred-network:pingdevice:3aadd7c9:online/removed","payload":"{\"channelUID\":\"network:pingdevice:3aadd7c9:online\",\"configuration\":{},\"itemName\":\"Presence_Mobile_Fred\"}","type":"ItemChannelLinkRemovedEvent"}`,
+			ItemChannelLinkRemoved{topic: "links/Presence_Mobile_Fred-network:pingdevice:3aadd7c9:online/removed", Link: Link{ItemName: "Presence_Mobile_Fred", ChannelUID: "network:pingdevice:3aadd7c9:online", Configuration: map[string]any{}}},
+		},
 		// {
 		// 	`"[{\"channels\":[{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#humidity\",\"id\":\"sensors#humidity\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fhumidity\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Humidity\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Humidity\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"%\",\"datatype\":\"float_\"}},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#luminance\",\"id\":\"sensors#luminance\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fluminance\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Luminance\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Luminance\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"float_\"}},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#motion\",\"id\":\"sensors#motion\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fmotion\",\"itemType\":\"Switch\",\"kind\":\"STATE\",\"label\":\"Motion\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Motion\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"boolean_\"}},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#temperature\",\"id\":\"sensors#temperature\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Ftemperature\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Temperature\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Temperature\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"°C\",\"datatype\":\"float_\"}}],\"label\":\"multisensor1\",\"bridgeUID\":\"mqtt:broker:6a75cc6119\",\"configuration\":{\"deviceid\":\"multisensor1\",\"removetopics\":false,\"basetopic\":\"homie\"},\"properties\":{\"homieversion\":\"4.0.0\"},\"UID\":\"mqtt:homie300:6a75cc6119:multisensor1\",\"thingTypeUID\":\"mqtt:homie300\"},{\"channels\":[{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#humidity\",\"id\":\"sensors#humidity\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fhumidity\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Humidity\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Humidity\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"%\",\"datatype\":\"float_\"},\"autoUpdatePolicy\":\"DEFAULT\"},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#luminance\",\"id\":\"sensors#luminance\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fluminance\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Luminance\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Luminance\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"float_\"},\"autoUpdatePolicy\":\"DEFAULT\"},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#motion\",\"id\":\"sensors#motion\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fmotion\",\"itemType\":\"Switch\",\"kind\":\"STATE\",\"label\":\"Motion\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Motion\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"boolean_\"},\"autoUpdatePolicy\":\"DEFAULT\"},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#temperature\",\"id\":\"sensors#temperature\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Ftemperature\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Temperature\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Temperature\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"°C\",\"datatype\":\"float_\"},\"autoUpdatePolicy\":\"DEFAULT\"}],\"label\":\"multisensor1\",\"bridgeUID\":\"mqtt:broker:6a75cc6119\",\"configuration\":{\"deviceid\":\"multisensor1\",\"removetopics\":false,\"basetopic\":\"homie\"},\"properties\":{\"homieversion\":\"4.0.0\"},\"UID\":\"mqtt:homie300:6a75cc6119:multisensor1\",\"thingTypeUID\":\"mqtt:homie300\"}]" ({"topic":"openhab/things/mqtt:homie300:6a75cc6119:multisensor1/updated","payload":"[{\"channels\":[{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#humidity\",\"id\":\"sensors#humidity\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fhumidity\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Humidity\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Humidity\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"%\",\"datatype\":\"float_\"}},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#luminance\",\"id\":\"sensors#luminance\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fluminance\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Luminance\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Luminance\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"float_\"}},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#motion\",\"id\":\"sensors#motion\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fmotion\",\"itemType\":\"Switch\",\"kind\":\"STATE\",\"label\":\"Motion\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Motion\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"boolean_\"}},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#temperature\",\"id\":\"sensors#temperature\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Ftemperature\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Temperature\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Temperature\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"°C\",\"datatype\":\"float_\"}}],\"label\":\"multisensor1\",\"bridgeUID\":\"mqtt:broker:6a75cc6119\",\"configuration\":{\"deviceid\":\"multisensor1\",\"removetopics\":false,\"basetopic\":\"homie\"},\"properties\":{\"homieversion\":\"4.0.0\"},\"UID\":\"mqtt:homie300:6a75cc6119:multisensor1\",\"thingTypeUID\":\"mqtt:homie300\"},{\"channels\":[{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#humidity\",\"id\":\"sensors#humidity\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fhumidity\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Humidity\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Humidity\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"%\",\"datatype\":\"float_\"},\"autoUpdatePolicy\":\"DEFAULT\"},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#luminance\",\"id\":\"sensors#luminance\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fluminance\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Luminance\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Luminance\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"float_\"},\"autoUpdatePolicy\":\"DEFAULT\"},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#motion\",\"id\":\"sensors#motion\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Fmotion\",\"itemType\":\"Switch\",\"kind\":\"STATE\",\"label\":\"Motion\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Motion\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"\",\"datatype\":\"boolean_\"},\"autoUpdatePolicy\":\"DEFAULT\"},{\"uid\":\"mqtt:homie300:6a75cc6119:multisensor1:sensors#temperature\",\"id\":\"sensors#temperature\",\"channelTypeUID\":\"mqtt:homie_2Fmultisensor1_2Fsensors_2Ftemperature\",\"itemType\":\"Number\",\"kind\":\"STATE\",\"label\":\"Temperature\",\"defaultTags\":[],\"properties\":{},\"configuration\":{\"format\":\"\",\"name\":\"Temperature\",\"retained\":\"true\",\"settable\":\"false\",\"unit\":\"°C\",\"datatype\":\"float_\"},\"autoUpdatePolicy\":\"DEFAULT\"}],\"label\":\"multisensor1\",\"bridgeUID\":\"mqtt:broker:6a75cc6119\",\"configuration\":{\"deviceid\":\"multisensor1\",\"removetopics\":false,\"basetopic\":\"homie\"},\"properties\":{\"homieversion\":\"4.0.0\"},\"UID\":\"mqtt:homie300:6a75cc6119:multisensor1\",\"thingTypeUID\":\"mqtt:homie300\"}]","type":"ThingUpdatedEvent"})`,
 		// },
@@ -0,0 +1,98 @@
+package event
+
+import "github.com/creativeprojects/gopenhab/api"
+
+// DiscoveryResult is an entry of the inbox
+type DiscoveryResult struct {
+	ThingUID               string
+	ThingTypeUID           string
+	BridgeUID              string
+	Flag                   string
+	Label                  string
+	Properties             map[string]any
+	RepresentationProperty string
+}
+
+type InboxAdded struct {
+	topic           string
+	DiscoveryResult DiscoveryResult
+}
+
+func NewInboxAdded(result DiscoveryResult) InboxAdded {
+	topic := inboxTopicPrefix + result.ThingUID + "/" + api.TopicEventAdded
+	return InboxAdded{
+		topic:           topic,
+		DiscoveryResult: result,
+	}
+}
+
+func (i InboxAdded) Topic() string {
+	return i.topic
+}
+
+func (i InboxAdded) Type() Type {
+	return TypeInboxAdded
+}
+
+func (i InboxAdded) String() string {
+	return "Inbox " + i.DiscoveryResult.ThingUID + " added"
+}
+
+// Verify interface
+var _ Event = InboxAdded{}
+
+type InboxRemoved struct {
+	topic           string
+	DiscoveryResult DiscoveryResult
+}
+
+func NewInboxRemoved(result DiscoveryResult) InboxRemoved {
+	topic := inboxTopicPrefix + result.ThingUID + "/" + api.TopicEventRemoved
+	return InboxRemoved{
+		topic:           topic,
+		DiscoveryResult: result,
+	}
+}
+
+func (i InboxRemoved) Topic() string {
+	return i.topic
+}
+
+func (i InboxRemoved) Type() Type {
+	return TypeInboxRemoved
+}
+
+func (i InboxRemoved) String() string {
+	return "Inbox " + i.DiscoveryResult.ThingUID + " removed"
+}
+
+// Verify interface
+var _ Event = InboxRemoved{}
+
+type InboxUpdated struct {
+	topic           string
+	DiscoveryResult DiscoveryResult
+}
+
+func NewInboxUpdated(result DiscoveryResult) InboxUpdated {
+	topic := inboxTopicPrefix + result.ThingUID + "/" + api.TopicEventUpdated
+	return InboxUpdated{
+		topic:           topic,
+		DiscoveryResult: result,
+	}
+}
+
+func (i InboxUpdated) Topic() string {
+	return i.topic
+}
+
+func (i InboxUpdated) Type() Type {
+	return TypeInboxUpdated
+}
+
+func (i InboxUpdated) String() string {
+	return "Inbox " + i.DiscoveryResult.ThingUID + " updated"
+}
+
+// Verify interface
+var _ Event = InboxUpdated{}
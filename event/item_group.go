@@ -27,7 +27,7 @@ func (i GroupItemStateUpdated) Topic() string {
 }
 
 func (i GroupItemStateUpdated) Type() Type {
-	return TypeItemState // should we create a new type for this?
+	return TypeGroupItemStateUpdated
 }
 
 func (i GroupItemStateUpdated) String() string {
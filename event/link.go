@@ -0,0 +1,71 @@
+package event
+
+import "github.com/creativeprojects/gopenhab/api"
+
+// Link between an item and a channel
+type Link struct {
+	ItemName      string
+	ChannelUID    string
+	Configuration map[string]any
+}
+
+// Name returns the name of the link as used in the topic of the link events
+func (l Link) Name() string {
+	return l.ItemName + "-" + l.ChannelUID
+}
+
+type ItemChannelLinkAdded struct {
+	topic string
+	Link  Link
+}
+
+func NewItemChannelLinkAdded(link Link) ItemChannelLinkAdded {
+	topic := linkTopicPrefix + link.Name() + "/" + api.TopicEventAdded
+	return ItemChannelLinkAdded{
+		topic: topic,
+		Link:  link,
+	}
+}
+
+func (i ItemChannelLinkAdded) Topic() string {
+	return i.topic
+}
+
+func (i ItemChannelLinkAdded) Type() Type {
+	return TypeItemChannelLinkAdded
+}
+
+func (i ItemChannelLinkAdded) String() string {
+	return "Link from item " + i.Link.ItemName + " to channel " + i.Link.ChannelUID + " added"
+}
+
+// Verify interface
+var _ Event = ItemChannelLinkAdded{}
+
+type ItemChannelLinkRemoved struct {
+	topic string
+	Link  Link
+}
+
+func NewItemChannelLinkRemoved(link Link) ItemChannelLinkRemoved {
+	topic := linkTopicPrefix + link.Name() + "/" + api.TopicEventRemoved
+	return ItemChannelLinkRemoved{
+		topic: topic,
+		Link:  link,
+	}
+}
+
+func (i ItemChannelLinkRemoved) Topic() string {
+	return i.topic
+}
+
+func (i ItemChannelLinkRemoved) Type() Type {
+	return TypeItemChannelLinkRemoved
+}
+
+func (i ItemChannelLinkRemoved) String() string {
+	return "Link from item " + i.Link.ItemName + " to channel " + i.Link.ChannelUID + " removed"
+}
+
+// Verify interface
+var _ Event = ItemChannelLinkRemoved{}
@@ -0,0 +1,74 @@
+package event
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+type patternKind int
+
+const (
+	patternExact patternKind = iota
+	patternGlob
+	patternRegexp
+)
+
+// Pattern matches the name of an item, a thing or a channel. A pattern can be:
+//   - an exact name, like "Temperature_Kitchen"
+//   - a glob pattern, like "Temperature_*" or "zwave:device:*:node?" (see path.Match for the syntax)
+//   - a regular expression surrounded by slashes, like "/^Temperature_(Kitchen|Lounge)$/"
+type Pattern struct {
+	raw    string
+	kind   patternKind
+	regexp *regexp.Regexp
+}
+
+// CompilePattern parses the pattern so it can be matched efficiently against many names.
+// It returns an error if the glob pattern or the regular expression is malformed.
+func CompilePattern(pattern string) (Pattern, error) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return Pattern{raw: pattern}, err
+		}
+		return Pattern{raw: pattern, kind: patternRegexp, regexp: re}, nil
+	}
+	if strings.ContainsAny(pattern, `*?[\`) {
+		// path.Match only returns an error when the pattern is malformed
+		if _, err := path.Match(pattern, ""); err != nil {
+			return Pattern{raw: pattern}, err
+		}
+		return Pattern{raw: pattern, kind: patternGlob}, nil
+	}
+	return Pattern{raw: pattern}, nil
+}
+
+// NewPattern returns the compiled pattern, or an exact name pattern when the pattern is malformed.
+// Use CompilePattern to check for errors.
+func NewPattern(pattern string) Pattern {
+	compiled, err := CompilePattern(pattern)
+	if err != nil {
+		return Pattern{raw: pattern}
+	}
+	return compiled
+}
+
+// Match returns true if the name matches the pattern.
+// An empty pattern matches any name.
+func (p Pattern) Match(name string) bool {
+	switch p.kind {
+	case patternGlob:
+		matched, _ := path.Match(p.raw, name)
+		return matched
+	case patternRegexp:
+		return p.regexp.MatchString(name)
+	default:
+		return p.raw == "" || p.raw == name
+	}
+}
+
+// String returns the pattern as it was defined
+func (p Pattern) String() string {
+	return p.raw
+}
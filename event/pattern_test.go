@@ -0,0 +1,59 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatternMatch(t *testing.T) {
+	t.Parallel()
+	testData := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"", "Anything", true},
+		{"Temperature_Kitchen", "Temperature_Kitchen", true},
+		{"Temperature_Kitchen", "Temperature_Lounge", false},
+		{"Temperature_*", "Temperature_Kitchen", true},
+		{"Temperature_*", "Humidity_Kitchen", false},
+		{"zwave:device:*:node?", "zwave:device:c4dcc784:node8", true},
+		{"zwave:device:*:node?", "zwave:device:c4dcc784:node10", false},
+		{"astro:sun:local:*#event", "astro:sun:local:set#event", true},
+		{"/^Temperature_(Kitchen|Lounge)$/", "Temperature_Lounge", true},
+		{"/^Temperature_(Kitchen|Lounge)$/", "Temperature_Bedroom", false},
+		{"/Kitchen/", "Temperature_Kitchen", true},
+	}
+
+	for _, testItem := range testData {
+		t.Run(testItem.pattern+"="+testItem.name, func(t *testing.T) {
+			t.Parallel()
+			pattern, err := CompilePattern(testItem.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, testItem.match, pattern.Match(testItem.name))
+		})
+	}
+}
+
+func TestInvalidPattern(t *testing.T) {
+	t.Parallel()
+	_, err := CompilePattern("Temperature_[")
+	assert.Error(t, err)
+
+	_, err = CompilePattern("/Temperature_(/")
+	assert.Error(t, err)
+
+	// an invalid pattern is compared as an exact name
+	assert.True(t, TypeItemState.Match("items/Temperature_[/state", "Temperature_["))
+}
+
+func TestNewPattern(t *testing.T) {
+	t.Parallel()
+	assert.True(t, NewPattern("Temperature_*").Match("Temperature_Kitchen"))
+	assert.True(t, NewPattern("/^Temperature/").Match("Temperature_Kitchen"))
+	// an invalid pattern is compared as an exact name
+	assert.True(t, NewPattern("Temperature_[").Match("Temperature_["))
+	assert.False(t, NewPattern("Temperature_[").Match("Temperature_Kitchen"))
+}
@@ -0,0 +1,126 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+// SitemapWidget is the new state of a widget sent by a sitemap subscription.
+// Visibility is false when the widget is hidden.
+type SitemapWidget struct {
+	WidgetID           string
+	Label              string
+	Icon               string
+	LabelColor         string
+	ValueColor         string
+	IconColor          string
+	Visibility         bool
+	State              string
+	ItemName           string
+	DescriptionChanged bool
+}
+
+// SitemapWidgetUpdated is sent when a widget of a subscribed sitemap page has been updated
+type SitemapWidgetUpdated struct {
+	topic       string
+	SitemapName string
+	PageID      string
+	Widget      SitemapWidget
+}
+
+func NewSitemapWidgetUpdated(sitemapName, pageID string, widget SitemapWidget) SitemapWidgetUpdated {
+	topic := sitemapTopicPrefix + sitemapName + "/" + pageID + "/" + widget.WidgetID + "/" + api.TopicEventUpdated
+	return SitemapWidgetUpdated{
+		topic:       topic,
+		SitemapName: sitemapName,
+		PageID:      pageID,
+		Widget:      widget,
+	}
+}
+
+func (s SitemapWidgetUpdated) Topic() string {
+	return s.topic
+}
+
+func (s SitemapWidgetUpdated) Type() Type {
+	return TypeSitemapWidgetUpdated
+}
+
+func (s SitemapWidgetUpdated) String() string {
+	return "Sitemap " + s.SitemapName + " widget " + s.Widget.WidgetID + " updated to " + s.Widget.State
+}
+
+// Verify interface
+var _ Event = SitemapWidgetUpdated{}
+
+// SitemapChanged is sent when the definition of a subscribed sitemap has changed: the page should be reloaded
+type SitemapChanged struct {
+	topic       string
+	SitemapName string
+	PageID      string
+}
+
+func NewSitemapChanged(sitemapName, pageID string) SitemapChanged {
+	topic := sitemapTopicPrefix + sitemapName + "/" + pageID + "/" + api.TopicEventChanged
+	return SitemapChanged{
+		topic:       topic,
+		SitemapName: sitemapName,
+		PageID:      pageID,
+	}
+}
+
+func (s SitemapChanged) Topic() string {
+	return s.topic
+}
+
+func (s SitemapChanged) Type() Type {
+	return TypeSitemapChanged
+}
+
+func (s SitemapChanged) String() string {
+	return "Sitemap " + s.SitemapName + " changed"
+}
+
+// Verify interface
+var _ Event = SitemapChanged{}
+
+// NewSitemapEvent decodes an event received from a sitemap subscription
+func NewSitemapEvent(data string) (Event, error) {
+	message := api.SitemapEvent{}
+	err := json.Unmarshal([]byte(data), &message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sitemap event data %q: %w", data, err)
+	}
+	switch message.Type {
+	case api.SitemapEventAlive:
+		return NewAliveEvent(), nil
+
+	case api.SitemapEventChanged:
+		return NewSitemapChanged(message.SitemapName, message.PageID), nil
+
+	case "":
+		if message.WidgetID == "" {
+			return nil, fmt.Errorf("missing widget ID in sitemap event %q", data)
+		}
+		widget := SitemapWidget{
+			WidgetID:           message.WidgetID,
+			Label:              message.Label,
+			Icon:               message.Icon,
+			LabelColor:         message.LabelColor,
+			ValueColor:         message.ValueColor,
+			IconColor:          message.IconColor,
+			Visibility:         message.Visibility,
+			State:              message.State,
+			DescriptionChanged: message.DescriptionChanged,
+		}
+		if message.Item != nil {
+			widget.ItemName = message.Item.Name
+		}
+		return NewSitemapWidgetUpdated(message.SitemapName, message.PageID, widget), nil
+
+	default:
+		return nil, fmt.Errorf("unknown sitemap event type %q", message.Type)
+	}
+}
@@ -0,0 +1,77 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSitemapEventFromJSON(t *testing.T) {
+	t.Parallel()
+	testData := []struct {
+		source string
+		event  Event
+	}{
+		{
+			`{"widgetId":"0000","label":"Light [ON]","labelcolor":"green","icon":"light","reloadIcon":false,"visibility":true,"descriptionChanged":false,"state":"ON","item":{"name":"Light","type":"Switch","state":"ON"},"sitemapName":"home","pageId":"home"}`,
+			SitemapWidgetUpdated{
+				topic:       "sitemaps/home/home/0000/updated",
+				SitemapName: "home",
+				PageID:      "home",
+				Widget: SitemapWidget{
+					WidgetID:   "0000",
+					Label:      "Light [ON]",
+					Icon:       "light",
+					LabelColor: "green",
+					Visibility: true,
+					State:      "ON",
+					ItemName:   "Light",
+				},
+			},
+		},
+		{
+			`{"widgetId":"0001","label":"Hidden","visibility":false,"sitemapName":"home","pageId":"0100"}`,
+			SitemapWidgetUpdated{
+				topic:       "sitemaps/home/0100/0001/updated",
+				SitemapName: "home",
+				PageID:      "0100",
+				Widget:      SitemapWidget{WidgetID: "0001", Label: "Hidden"},
+			},
+		},
+		{
+			`{"TYPE":"SITEMAP_CHANGED","sitemapName":"home","pageId":"home"}`,
+			SitemapChanged{topic: "sitemaps/home/home/changed", SitemapName: "home", PageID: "home"},
+		},
+		{
+			`{"TYPE":"ALIVE","sitemapName":"home","pageId":"home"}`,
+			AliveEvent{},
+		},
+	}
+
+	for _, testItem := range testData {
+		t.Run(testItem.source, func(t *testing.T) {
+			t.Parallel()
+			e, err := NewSitemapEvent(testItem.source)
+			require.NoError(t, err)
+			assert.Equal(t, testItem.event, e)
+		})
+	}
+}
+
+func TestInvalidSitemapEvent(t *testing.T) {
+	t.Parallel()
+	testData := []string{
+		``,
+		`{"sitemapName":"home","pageId":"home"}`,
+		`{"TYPE":"UNKNOWN","sitemapName":"home","pageId":"home"}`,
+	}
+
+	for _, source := range testData {
+		t.Run(source, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewSitemapEvent(source)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import "fmt"
 type subscription struct {
 	id        int
 	name      string
+	pattern   Pattern
 	eventType Type
 	callback  func(e Event)
 	once      bool
@@ -24,6 +24,7 @@ type ThingStatusInfoEvent struct {
 	ThingName    string
 	Status       string
 	StatusDetail string
+	Description  string
 }
 
 // NewThingStatusInfoEvent create a ThingStatusInfoEvent.
@@ -35,6 +36,7 @@ func NewThingStatusInfoEvent(thingName string, status ThingStatus) ThingStatusIn
 		ThingName:    thingName,
 		Status:       status.Status,
 		StatusDetail: status.StatusDetail,
+		Description:  status.Description,
 	}
 }
 
@@ -43,7 +45,7 @@ func (i ThingStatusInfoEvent) Topic() string {
 }
 
 func (i ThingStatusInfoEvent) Type() Type {
-	return TypeItemCommand
+	return TypeThingStatusInfo
 }
 
 func (i ThingStatusInfoEvent) String() string {
@@ -85,7 +87,7 @@ func (i ThingStatusInfoChangedEvent) Topic() string {
 }
 
 func (i ThingStatusInfoChangedEvent) Type() Type {
-	return TypeItemCommand
+	return TypeThingStatusInfoChanged
 }
 
 func (i ThingStatusInfoChangedEvent) String() string {
@@ -95,6 +97,62 @@ func (i ThingStatusInfoChangedEvent) String() string {
 // Verify interface
 var _ Event = ThingStatusInfoChangedEvent{}
 
+type ThingAdded struct {
+	topic string
+	Thing Thing
+}
+
+func NewThingAdded(thing Thing) ThingAdded {
+	topic := thingTopicPrefix + thing.UID + "/" + api.TopicEventAdded
+	return ThingAdded{
+		topic: topic,
+		Thing: thing,
+	}
+}
+
+func (i ThingAdded) Topic() string {
+	return i.topic
+}
+
+func (i ThingAdded) Type() Type {
+	return TypeThingAdded
+}
+
+func (i ThingAdded) String() string {
+	return "Thing " + i.Thing.UID + " added"
+}
+
+// Verify interface
+var _ Event = ThingAdded{}
+
+type ThingRemoved struct {
+	topic string
+	Thing Thing
+}
+
+func NewThingRemoved(thing Thing) ThingRemoved {
+	topic := thingTopicPrefix + thing.UID + "/" + api.TopicEventRemoved
+	return ThingRemoved{
+		topic: topic,
+		Thing: thing,
+	}
+}
+
+func (i ThingRemoved) Topic() string {
+	return i.topic
+}
+
+func (i ThingRemoved) Type() Type {
+	return TypeThingRemoved
+}
+
+func (i ThingRemoved) String() string {
+	return "Thing " + i.Thing.UID + " removed"
+}
+
+// Verify interface
+var _ Event = ThingRemoved{}
+
 type ThingUpdated struct {
 	topic    string
 	OldThing Thing
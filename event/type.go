@@ -11,6 +11,9 @@ const (
 	itemTopicPrefix    = "items/"
 	thingTopicPrefix   = "things/"
 	channelTopicPrefix = "channels/"
+	inboxTopicPrefix   = "inbox/"
+	linkTopicPrefix    = "links/"
+	sitemapTopicPrefix = "sitemaps/"
 )
 
 type Type int
@@ -42,35 +45,114 @@ const (
 	TypeThingStatusInfoChanged // The status of a thing changed.
 	TypeInboxAdded             // A discovery result has been added to the inbox.
 	TypeInboxRemoved           // A discovery result has been removed from the inbox.
-	TypeInboxUpdate            // A discovery result has been updated in the inbox.
+	TypeInboxUpdated           // A discovery result has been updated in the inbox.
 	TypeItemChannelLinkAdded   // An item channel link has been added to the registry.
 	TypeItemChannelLinkRemoved // An item channel link has been removed from the registry.
 	TypeChannelTriggered       // A channel has been triggered.
+	TypeGroupItemStateUpdated  // The state of a group item has been updated through a member.
+	TypeSitemapWidgetUpdated   // A widget of a subscribed sitemap page has been updated.
+	TypeSitemapChanged         // The definition of a subscribed sitemap has changed.
 )
 
-// Match returns true if the name matches the topic
+// TypeInboxUpdate is the previous name of TypeInboxUpdated.
+//
+// Deprecated: use TypeInboxUpdated instead.
+const TypeInboxUpdate = TypeInboxUpdated
+
+// Match returns true if the name matches the topic.
+// The name can be an exact name, a glob pattern or a regular expression (see Pattern).
+// The pattern is compiled at each call: prefer MatchPattern when matching many topics.
 func (t Type) Match(topic, name string) bool {
+	return t.MatchPattern(topic, NewPattern(name))
+}
+
+// MatchPattern returns true if the name found in the topic matches the pattern
+func (t Type) MatchPattern(topic string, pattern Pattern) bool {
 	switch t {
 	case TypeUnknown, TypeClientStarted, TypeClientConnected, TypeClientConnectionStable,
 		TypeClientDisconnected, TypeClientStopped, TypeClientError, TypeTimeCron:
 		return true
+	}
+	name, ok := t.topicName(topic)
+	if !ok {
+		return false
+	}
+	return pattern.Match(name)
+}
+
+// topicName returns the name of the item, thing or channel found in the topic.
+// It returns false if the topic doesn't correspond to the event type.
+func (t Type) topicName(topic string) (string, bool) {
+	prefix, suffix := t.topicParts()
+	if !strings.HasPrefix(topic, prefix) || !strings.HasSuffix(topic, suffix) ||
+		len(topic) <= len(prefix)+len(suffix) {
+		return "", false
+	}
+	name := topic[len(prefix) : len(topic)-len(suffix)]
+	if t == TypeGroupItemStateChanged {
+		// topic is items/<group>/<member>/statechanged, and the group's own items/<group>/statechanged also matches
+		group, _, _ := strings.Cut(name, "/")
+		return group, true
+	}
+	if t == TypeGroupItemStateUpdated {
+		// topic is items/<group>/<member>/stateupdated
+		group, _, found := strings.Cut(name, "/")
+		return group, found
+	}
+	if t == TypeSitemapWidgetUpdated || t == TypeSitemapChanged {
+		// topic is sitemaps/<sitemap>/<page>/<widget>/updated or sitemaps/<sitemap>/<page>/changed
+		sitemap, _, found := strings.Cut(name, "/")
+		return sitemap, found
+	}
+	if strings.Contains(name, "/") {
+		return "", false
+	}
+	return name, true
+}
+
+// topicParts returns the topic prefix and suffix surrounding the name
+func (t Type) topicParts() (string, string) {
+	switch t {
 	case TypeItemAdded:
-		return topic == itemTopicPrefix+name+"/"+api.TopicEventAdded
+		return itemTopicPrefix, "/" + api.TopicEventAdded
 	case TypeItemRemoved:
-		return topic == itemTopicPrefix+name+"/"+api.TopicEventRemoved
+		return itemTopicPrefix, "/" + api.TopicEventRemoved
 	case TypeItemUpdated:
-		return topic == itemTopicPrefix+name+"/"+api.TopicEventUpdated
+		return itemTopicPrefix, "/" + api.TopicEventUpdated
 	case TypeItemCommand:
-		return topic == itemTopicPrefix+name+"/"+api.TopicEventCommand
+		return itemTopicPrefix, "/" + api.TopicEventCommand
 	case TypeItemState:
-		return topic == itemTopicPrefix+name+"/"+api.TopicEventState
-	case TypeItemStateChanged:
-		return topic == itemTopicPrefix+name+"/"+api.TopicEventStateChanged
-	case TypeGroupItemStateChanged:
-		return strings.HasPrefix(topic, itemTopicPrefix+name+"/") &&
-			strings.HasSuffix(topic, "/"+api.TopicEventStateChanged)
+		return itemTopicPrefix, "/" + api.TopicEventState
+	case TypeItemStateChanged, TypeGroupItemStateChanged:
+		return itemTopicPrefix, "/" + api.TopicEventStateChanged
+	case TypeGroupItemStateUpdated:
+		return itemTopicPrefix, "/" + api.TopicEventStateUpdated
+	case TypeThingAdded:
+		return thingTopicPrefix, "/" + api.TopicEventAdded
+	case TypeThingRemoved:
+		return thingTopicPrefix, "/" + api.TopicEventRemoved
+	case TypeThingUpdated:
+		return thingTopicPrefix, "/" + api.TopicEventUpdated
 	case TypeThingStatusInfo:
-		return topic == thingTopicPrefix+name+"/"+api.TopicEventStatus
+		return thingTopicPrefix, "/" + api.TopicEventStatus
+	case TypeThingStatusInfoChanged:
+		return thingTopicPrefix, "/" + api.TopicEventStatusChanged
+	case TypeItemChannelLinkAdded:
+		return linkTopicPrefix, "/" + api.TopicEventAdded
+	case TypeItemChannelLinkRemoved:
+		return linkTopicPrefix, "/" + api.TopicEventRemoved
+	case TypeChannelTriggered:
+		return channelTopicPrefix, "/" + api.TopicEventTriggered
+	case TypeInboxAdded:
+		return inboxTopicPrefix, "/" + api.TopicEventAdded
+	case TypeInboxRemoved:
+		return inboxTopicPrefix, "/" + api.TopicEventRemoved
+	case TypeInboxUpdated:
+		return inboxTopicPrefix, "/" + api.TopicEventUpdated
+	case TypeSitemapWidgetUpdated:
+		return sitemapTopicPrefix, "/" + api.TopicEventUpdated
+	case TypeSitemapChanged:
+		return sitemapTopicPrefix, "/" + api.TopicEventChanged
 	default:
 		panic(fmt.Sprintf("event.Type %d Match undefined", t))
 	}
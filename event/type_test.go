@@ -0,0 +1,65 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeMatch(t *testing.T) {
+	t.Parallel()
+	testData := []struct {
+		eventType Type
+		topic     string
+		name      string
+		match     bool
+	}{
+		{TypeItemState, "items/TestItem/state", "TestItem", true},
+		{TypeItemState, "items/TestItem/state", "OtherItem", false},
+		{TypeItemState, "items/TestItem/state", "Test*", true},
+		{TypeItemState, "items/TestItem/statechanged", "Test*", false},
+		{TypeItemStateChanged, "items/Temperature_Kitchen/statechanged", "Temperature_*", true},
+		{TypeItemStateChanged, "items/Humidity_Kitchen/statechanged", "Temperature_*", false},
+		{TypeItemStateChanged, "items/Temperature_Kitchen/statechanged", "/_(Kitchen|Lounge)$/", true},
+		{TypeGroupItemStateChanged, "items/Temperatures/Temperature_Kitchen/statechanged", "Temperatures", true},
+		{TypeGroupItemStateChanged, "items/Temperatures/Temperature_Kitchen/statechanged", "Temp*", true},
+		{TypeGroupItemStateChanged, "items/Temperatures/statechanged", "Temperatures", true},
+		{TypeGroupItemStateChanged, "items/Temperatures/statechanged", "Humidities", false},
+		{TypeGroupItemStateUpdated, "items/Temperatures/Temperature_Kitchen/stateupdated", "Temperatures", true},
+		{TypeGroupItemStateUpdated, "items/Temperatures/Temperature_Kitchen/statechanged", "Temperatures", false},
+		{TypeGroupItemStateUpdated, "items/Temperatures/stateupdated", "Temperatures", false},
+		{TypeThingStatusInfoChanged, "things/zwave:device:1:node8/statuschanged", "zwave:*", true},
+		{TypeThingStatusInfoChanged, "things/mqtt:homie300:1:test/statuschanged", "zwave:*", false},
+		{TypeThingStatusInfo, "things/zwave:device:1:node8/status", "zwave:device:1:node8", true},
+		{TypeThingStatusInfo, "things/zwave:device:1:node8/status", "zwave:device:1:node9", false},
+		{TypeThingStatusInfoChanged, "things/zwave:device:1:node8/statuschanged", "zwave:device:1:node8", true},
+		{TypeThingStatusInfoChanged, "things/zwave:device:1:node8/status", "zwave:device:1:node8", false},
+		{TypeThingUpdated, "things/zwave:device:1:node8/updated", "zwave:device:1:node8", true},
+		{TypeThingUpdated, "things/zwave:device:1:node8/updated", "zwave:device:1:node9", false},
+		{TypeThingAdded, "things/astro:sun:local/added", "astro:sun:local", true},
+		{TypeThingAdded, "things/astro:sun:local/removed", "astro:sun:local", false},
+		{TypeThingRemoved, "things/astro:sun:local/removed", "astro:sun:local", true},
+		{TypeThingRemoved, "things/astro:sun:local/removed", "astro:moon:local", false},
+		{TypeChannelTriggered, "channels/astro:sun:local:set#event/triggered", "astro:sun:local:set#event", true},
+		{TypeChannelTriggered, "channels/astro:sun:local:set#event/triggered", "astro:sun:local:rise#event", false},
+		{TypeChannelTriggered, "channels/astro:sun:local:set#event/triggered", "astro:sun:*", true},
+		{TypeItemChannelLinkAdded, "links/Lamp-hue:0220:1:5:color/added", "Lamp-hue:0220:1:5:color", true},
+		{TypeItemChannelLinkRemoved, "links/Lamp-hue:0220:1:5:color/added", "Lamp-hue:0220:1:5:color", false},
+		{TypeInboxAdded, "inbox/hue:0220:1:5/added", "hue:0220:1:5", true},
+		{TypeInboxRemoved, "inbox/hue:0220:1:5/removed", "hue:0220:1:5", true},
+		{TypeInboxUpdated, "inbox/hue:0220:1:5/updated", "hue:0220:1:5", true},
+		{TypeInboxUpdated, "inbox/hue:0220:1:5/updated", "hue:0220:1:6", false},
+		{TypeSitemapWidgetUpdated, "sitemaps/home/0100/0101/updated", "home", true},
+		{TypeSitemapWidgetUpdated, "sitemaps/home/0100/0101/updated", "other", false},
+		{TypeSitemapWidgetUpdated, "sitemaps/home/0100/changed", "home", false},
+		{TypeSitemapChanged, "sitemaps/home/home/changed", "home", true},
+		{TypeSitemapChanged, "sitemaps/home/changed", "home", false},
+	}
+
+	for _, testItem := range testData {
+		t.Run(testItem.topic, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testItem.match, testItem.eventType.Match(testItem.topic, testItem.name))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+const (
+	actionsPath = "actions/"
+)
+
+// GetThingActions returns the list of actions available on a thing, with the description of their inputs and outputs.
+// Thing actions are only available from openHAB 3.
+func (c *Client) GetThingActions(ctx context.Context, thingUID string) ([]api.ThingAction, error) {
+	actions := make([]api.ThingAction, 0)
+	err := c.getJSON(ctx, actionsPath+url.PathEscape(thingUID), &actions)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load actions of thing %q: %w", thingUID, err)
+	}
+	return actions, nil
+}
+
+// InvokeAction runs the action actionUID of the thing thingUID, and returns the outputs of the action.
+// The inputs are sent using the input names as keys (as described in the ActionInput returned by GetThingActions).
+//
+// It returns ErrNotFound if either the thing or the action doesn't exist.
+func (c *Client) InvokeAction(ctx context.Context, thingUID, actionUID string, inputs map[string]any) (map[string]any, error) {
+	if inputs == nil {
+		inputs = map[string]any{}
+	}
+	outputs := make(map[string]any)
+	err := c.postJSON(ctx, actionsPath+url.PathEscape(thingUID)+"/"+url.PathEscape(actionUID), inputs, &outputs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot invoke action %q on thing %q: %w", actionUID, thingUID, err)
+	}
+	c.addCounter(MetricThingActionInvoked, 1, MetricThingUID, thingUID)
+	return outputs, nil
+}
@@ -0,0 +1,88 @@
+package openhab
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThingActions(t *testing.T) {
+	t.Parallel()
+	const (
+		thingUID  = "mqtt:broker:mosquitto"
+		actionUID = "mqtt.publishMQTT#a49b0fc2e3a3e2f4c5d6e7f8091a2b3c"
+		failUID   = "mqtt.fail#0f1e2d3c4b5a69788796a5b4c3d2e1f0"
+	)
+	publish := api.ThingAction{
+		ActionUID: actionUID,
+		Label:     "publish an MQTT message",
+		Inputs: []api.ActionInput{
+			{Name: "topic", Type: "java.lang.String", Label: "Topic", Required: true},
+			{Name: "message", Type: "java.lang.String", Label: "Message", Required: true},
+			{Name: "retain", Type: "java.lang.Boolean", Label: "Retain"},
+		},
+		Outputs: []api.ActionOutput{
+			{Name: "success", Type: "java.lang.Boolean", Label: "Success"},
+		},
+	}
+
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	received := make(map[string]any)
+	require.NoError(t, server.SetThingAction(thingUID, publish, func(inputs map[string]any) (map[string]any, error) {
+		received = inputs
+		return map[string]any{"success": true}, nil
+	}))
+	require.NoError(t, server.SetThingAction(thingUID, api.ThingAction{ActionUID: failUID}, func(inputs map[string]any) (map[string]any, error) {
+		return nil, errors.New("failed")
+	}))
+
+	client := NewClient(Config{URL: server.URL()})
+	ctx := context.Background()
+
+	t.Run("TestGetThingActions", func(t *testing.T) {
+		actions, err := client.GetThingActions(ctx, thingUID)
+		require.NoError(t, err)
+		require.Len(t, actions, 2)
+		assert.Equal(t, actionUID, actions[0].ActionUID)
+		assert.Equal(t, publish.Inputs, actions[0].Inputs)
+		assert.Equal(t, publish.Outputs, actions[0].Outputs)
+	})
+
+	t.Run("TestGetThingActionsNoAction", func(t *testing.T) {
+		actions, err := client.GetThingActions(ctx, "astro:sun:local")
+		require.NoError(t, err)
+		assert.Empty(t, actions)
+	})
+
+	t.Run("TestInvokeAction", func(t *testing.T) {
+		inputs := map[string]any{"topic": "test/topic", "message": "hello", "retain": true}
+		outputs, err := client.InvokeAction(ctx, thingUID, actionUID, inputs)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"success": true}, outputs)
+		assert.Equal(t, inputs, received)
+	})
+
+	t.Run("TestInvokeActionMissingInput", func(t *testing.T) {
+		_, err := client.InvokeAction(ctx, thingUID, actionUID, map[string]any{"topic": "test/topic"})
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("TestInvokeActionNotFound", func(t *testing.T) {
+		_, err := client.InvokeAction(ctx, thingUID, "mqtt.unknown", nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("TestInvokeActionError", func(t *testing.T) {
+		_, err := client.InvokeAction(ctx, thingUID, failUID, nil)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, server.ActionsErr())
+}
@@ -0,0 +1,169 @@
+package openhab
+
+// Command is a value which can be sent to an item.
+// Most states can also be sent as a command (like SwitchON or a DecimalState),
+// but some commands have no equivalent state (like CommandINCREASE or CommandREFRESH).
+type Command interface {
+	String() string
+}
+
+// Verify interfaces
+var (
+	_ Command = IncreaseDecreaseCommand("")
+	_ Command = StopMoveCommand("")
+	_ Command = PlayPauseCommand("")
+	_ Command = NextPreviousCommand("")
+	_ Command = RewindFastforwardCommand("")
+	_ Command = RefreshCommand("")
+)
+
+// IncreaseDecreaseCommand can be sent to Dimmer and Color items
+type IncreaseDecreaseCommand string
+
+const (
+	CommandINCREASE IncreaseDecreaseCommand = "INCREASE"
+	CommandDECREASE IncreaseDecreaseCommand = "DECREASE"
+)
+
+func (c IncreaseDecreaseCommand) String() string {
+	return string(c)
+}
+
+// UP and DOWN commands can be sent to Rollershutter items
+const (
+	CommandUP   = RollershutterUP
+	CommandDOWN = RollershutterDOWN
+)
+
+// StopMoveCommand can be sent to Rollershutter items
+type StopMoveCommand string
+
+const (
+	CommandSTOP StopMoveCommand = "STOP"
+	CommandMOVE StopMoveCommand = "MOVE"
+)
+
+func (c StopMoveCommand) String() string {
+	return string(c)
+}
+
+// PlayPauseCommand can be sent to Player items
+type PlayPauseCommand string
+
+const (
+	CommandPLAY  PlayPauseCommand = "PLAY"
+	CommandPAUSE PlayPauseCommand = "PAUSE"
+)
+
+func (c PlayPauseCommand) String() string {
+	return string(c)
+}
+
+// NextPreviousCommand can be sent to Player items
+type NextPreviousCommand string
+
+const (
+	CommandNEXT     NextPreviousCommand = "NEXT"
+	CommandPREVIOUS NextPreviousCommand = "PREVIOUS"
+)
+
+func (c NextPreviousCommand) String() string {
+	return string(c)
+}
+
+// RewindFastforwardCommand can be sent to Player items
+type RewindFastforwardCommand string
+
+const (
+	CommandREWIND      RewindFastforwardCommand = "REWIND"
+	CommandFASTFORWARD RewindFastforwardCommand = "FASTFORWARD"
+)
+
+func (c RewindFastforwardCommand) String() string {
+	return string(c)
+}
+
+// RefreshCommand asks the binding to refresh the state of the item. All item types accept it.
+type RefreshCommand string
+
+const (
+	CommandREFRESH RefreshCommand = "REFRESH"
+)
+
+func (c RefreshCommand) String() string {
+	return string(c)
+}
+
+// AcceptsCommand returns true if an item of this type accepts the command.
+// A StringState command is untyped: it is always accepted and sent as it is, openHAB being in charge of parsing it.
+// String items accept any command as text. Group and unknown item types accept any command:
+// use the base type of a group (see Item.GroupType) to validate the commands sent to a group.
+func (t ItemType) AcceptsCommand(command Command) bool {
+	if command == nil {
+		return false
+	}
+	if _, ok := command.(StringState); ok {
+		return true
+	}
+	if _, ok := command.(RefreshCommand); ok {
+		return true
+	}
+	switch t {
+	case ItemTypeSwitch:
+		switch command.(type) {
+		case SwitchState:
+			return true
+		}
+	case ItemTypeDimmer:
+		switch command.(type) {
+		case SwitchState, IncreaseDecreaseCommand, PercentState, DecimalState:
+			return true
+		}
+	case ItemTypeColor:
+		switch command.(type) {
+		case HSBState, SwitchState, IncreaseDecreaseCommand, PercentState, DecimalState:
+			return true
+		}
+	case ItemTypeRollershutter:
+		switch command.(type) {
+		case UpDownState, StopMoveCommand, RollershutterState, PercentState, DecimalState:
+			return true
+		}
+	case ItemTypeNumber:
+		switch command.(type) {
+		case DecimalState:
+			return true
+		}
+	case ItemTypeString:
+		// any command is received as text
+		return true
+	case ItemTypeDateTime:
+		switch command.(type) {
+		case DateTimeState:
+			return true
+		}
+	case ItemTypeLocation:
+		switch command.(type) {
+		case PointState:
+			return true
+		}
+	case ItemTypePlayer:
+		switch command.(type) {
+		case PlayPauseCommand, NextPreviousCommand, RewindFastforwardCommand:
+			return true
+		}
+	case ItemTypeContact, ItemTypeImage:
+		// these items only accept REFRESH
+	default:
+		return true
+	}
+	return false
+}
+
+// commandString returns the string value of the command, even if nil
+func commandString(command Command) string {
+	if command == nil {
+		return "<nil>"
+	}
+	return command.String()
+}
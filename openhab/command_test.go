@@ -0,0 +1,129 @@
+package openhab
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemTypeAcceptsCommand(t *testing.T) {
+	t.Parallel()
+	fixtures := []struct {
+		itemType ItemType
+		command  Command
+		accepted bool
+	}{
+		{ItemTypeSwitch, SwitchON, true},
+		{ItemTypeSwitch, CommandREFRESH, true},
+		{ItemTypeSwitch, CommandINCREASE, false},
+		{ItemTypeSwitch, StringState("OFF"), true},
+		{ItemTypeSwitch, StringState("50"), true},
+		{ItemTypeDimmer, NewPercentState(50), true},
+		{ItemTypeDimmer, CommandDECREASE, true},
+		{ItemTypeDimmer, SwitchOFF, true},
+		{ItemTypeDimmer, StringState("INCREASE"), true},
+		{ItemTypeDimmer, CommandUP, false},
+		{ItemTypeColor, NewHSBState(120, 80, 50), true},
+		{ItemTypeColor, StringState("120,80,50"), true},
+		{ItemTypeColor, StringState("80"), true},
+		{ItemTypeColor, CommandSTOP, false},
+		{ItemTypeRollershutter, CommandUP, true},
+		{ItemTypeRollershutter, CommandSTOP, true},
+		{ItemTypeRollershutter, StringState("MOVE"), true},
+		{ItemTypeRollershutter, NewPercentState(30), true},
+		{ItemTypeRollershutter, SwitchON, false},
+		{ItemTypeNumber, NewDecimalState(20, "°C"), true},
+		{ItemTypeNumber, StringState("20.5"), true},
+		{ItemTypeNumber, StringState("21°C"), true},
+		{ItemTypeDateTime, StringState("2024-01-01T10:00:00"), true},
+		{ItemTypeDateTime, SwitchON, false},
+		{ItemTypeNumber, SwitchON, false},
+		{ItemTypeString, StringState("anything"), true},
+		{ItemTypeString, CommandREFRESH, true},
+		{ItemTypeString, CommandPLAY, true},
+		{ItemTypeString, SwitchON, true},
+		{ItemTypeString, NewDecimalState(20, "°C"), true},
+		{ItemTypePlayer, CommandPLAY, true},
+		{ItemTypePlayer, CommandPREVIOUS, true},
+		{ItemTypePlayer, StringState("NEXT"), true},
+		{ItemTypePlayer, CommandFASTFORWARD, true},
+		{ItemTypePlayer, StringState("REWIND"), true},
+		{ItemTypePlayer, CommandUP, false},
+		{ItemTypeLocation, NewPointState(52.52, 13.4), true},
+		{ItemTypeLocation, StringState("52.52,13.4"), true},
+		{ItemTypeContact, ContactOPEN, false},
+		{ItemTypeContact, CommandREFRESH, true},
+		{ItemTypeImage, StringState("REFRESH"), true},
+		{ItemTypeGroup, CommandINCREASE, true},
+		{ItemTypeGroup, StringState("whatever"), true},
+		{ItemTypeUnknown, CommandMOVE, true},
+		{ItemTypeSwitch, nil, false},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(string(fixture.itemType)+" "+commandString(fixture.command), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, fixture.accepted, fixture.itemType.AcceptsCommand(fixture.command))
+		})
+	}
+}
+
+func TestSendInvalidCommand(t *testing.T) {
+	t.Parallel()
+	// the command is rejected before calling the API: the item doesn't need a client
+	item := newTestItem(nil, "Light", "Switch", "OFF")
+	err := item.SendCommandContext(context.Background(), CommandINCREASE)
+	assert.ErrorIs(t, err, ErrInvalidCommand)
+
+	err = item.SendCommandContext(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrInvalidCommand)
+}
+
+func TestSendInvalidCommandToGroup(t *testing.T) {
+	t.Parallel()
+	// the command is validated against the base type of the group
+	group := newItem(nil, "Lights").set(api.Item{Name: "Lights", Type: "Group", GroupType: "Switch", State: "OFF"})
+	err := group.SendCommandContext(context.Background(), CommandINCREASE)
+	assert.ErrorIs(t, err, ErrInvalidCommand)
+
+	group = newItem(nil, "Shutters").set(api.Item{Name: "Shutters", Type: "Group", GroupType: "Rollershutter", State: "0"})
+	err = group.SendCommandContext(context.Background(), SwitchON)
+	assert.ErrorIs(t, err, ErrInvalidCommand)
+}
+
+func TestSendUntypedCommand(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t,
+		api.Item{Name: "Alarm", Type: "DateTime", State: "NULL"},
+		api.Item{Name: "Temperature", Type: "Number:Temperature", State: "20 °C"},
+	)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+	// these values are parsed by openHAB: they are sent as they are
+	fixtures := []struct {
+		itemName string
+		command  string
+	}{
+		{"Alarm", "2024-01-01T10:00:00"},
+		{"Temperature", "21°C"},
+	}
+	for _, fixture := range fixtures {
+		err := client.SendCommand(fixture.itemName, StringState(fixture.command))
+		require.NoError(t, err)
+
+		resp, err := http.Get(server.URL() + "/rest/items/" + fixture.itemName + "/state")
+		require.NoError(t, err)
+		state, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		assert.Equal(t, fixture.command, string(state))
+	}
+
+	assert.NoError(t, server.ItemsErr())
+}
@@ -4,5 +4,11 @@ import "errors"
 
 var (
 	ErrNotFound             = errors.New("not found")
+	ErrConflict             = errors.New("conflict")
+	ErrBadRequest           = errors.New("bad request")
 	ErrRuleAlreadyActivated = errors.New("rule already activated")
+	ErrInvalidCommand       = errors.New("invalid command")
+	ErrUnknownUnit          = errors.New("unknown unit")
+	ErrIncompatibleUnit     = errors.New("incompatible unit")
+	ErrNotDecimal           = errors.New("not a decimal state")
 )
@@ -0,0 +1,87 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+const (
+	inboxPath = "inbox/"
+)
+
+// GetInbox returns the discovery results waiting in the inbox.
+// Set includeIgnored to true to also receive the results previously ignored.
+func (c *Client) GetInbox(includeIgnored bool) ([]api.DiscoveryResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetInboxContext(ctx, includeIgnored)
+}
+
+// GetInboxContext returns the discovery results waiting in the inbox.
+// Set includeIgnored to true to also receive the results previously ignored.
+func (c *Client) GetInboxContext(ctx context.Context, includeIgnored bool) ([]api.DiscoveryResult, error) {
+	results := make([]api.DiscoveryResult, 0)
+	path := "inbox"
+	if includeIgnored {
+		path += "?includeIgnored=true"
+	}
+	err := c.getJSON(ctx, path, &results)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load inbox: %w", err)
+	}
+	return results, nil
+}
+
+// ApproveInbox approves the discovery result: a new thing is created with the label.
+// If label is empty, the label of the discovery result is used.
+func (c *Client) ApproveInbox(thingUID, label string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.ApproveInboxContext(ctx, thingUID, label)
+}
+
+// ApproveInboxContext approves the discovery result: a new thing is created with the label.
+// If label is empty, the label of the discovery result is used.
+func (c *Client) ApproveInboxContext(ctx context.Context, thingUID, label string) error {
+	err := c.postString(ctx, inboxPath+url.PathEscape(thingUID)+"/approve", label)
+	if err != nil {
+		return fmt.Errorf("cannot approve inbox %q: %w", thingUID, err)
+	}
+	return nil
+}
+
+// IgnoreInbox flags the discovery result as ignored: it stays in the inbox but won't be listed by default.
+func (c *Client) IgnoreInbox(thingUID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.IgnoreInboxContext(ctx, thingUID)
+}
+
+// IgnoreInboxContext flags the discovery result as ignored: it stays in the inbox but won't be listed by default.
+func (c *Client) IgnoreInboxContext(ctx context.Context, thingUID string) error {
+	err := c.postString(ctx, inboxPath+url.PathEscape(thingUID)+"/ignore", "")
+	if err != nil {
+		return fmt.Errorf("cannot ignore inbox %q: %w", thingUID, err)
+	}
+	return nil
+}
+
+// RemoveInbox removes the discovery result from the inbox.
+func (c *Client) RemoveInbox(thingUID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.RemoveInboxContext(ctx, thingUID)
+}
+
+// RemoveInboxContext removes the discovery result from the inbox.
+func (c *Client) RemoveInboxContext(ctx context.Context, thingUID string) error {
+	err := c.send(ctx, http.MethodDelete, inboxPath+url.PathEscape(thingUID), "", http.NoBody, nil)
+	if err != nil {
+		return fmt.Errorf("cannot remove inbox %q: %w", thingUID, err)
+	}
+	return nil
+}
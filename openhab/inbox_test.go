@@ -0,0 +1,86 @@
+package openhab
+
+import (
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxAPI(t *testing.T) {
+	t.Parallel()
+	lamp := api.DiscoveryResult{
+		ThingUID:     "hue:0220:001788a1b2c3:5",
+		ThingTypeUID: "hue:0220",
+		BridgeUID:    "hue:bridge:001788a1b2c3",
+		Label:        "Hue color lamp",
+		Properties:   map[string]any{"uniqueId": "00:17:88:01:00:bd:c7:b9-0b"},
+	}
+	sensor := api.DiscoveryResult{
+		ThingUID:     "hue:0107:001788a1b2c3:7",
+		ThingTypeUID: "hue:0107",
+		Label:        "Hue motion sensor",
+	}
+	plug := api.DiscoveryResult{
+		ThingUID:     "hue:0010:001788a1b2c3:9",
+		ThingTypeUID: "hue:0010",
+		Label:        "Hue smart plug",
+		Flag:         api.DiscoveryFlagIgnored,
+	}
+
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetInboxResult(lamp))
+	require.NoError(t, server.SetInboxResult(sensor))
+	require.NoError(t, server.SetInboxResult(plug))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	t.Run("TestGetInbox", func(t *testing.T) {
+		results, err := client.GetInbox(false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, sensor.ThingUID, results[0].ThingUID)
+		assert.Equal(t, lamp.ThingUID, results[1].ThingUID)
+		assert.Equal(t, api.DiscoveryFlagNew, results[1].Flag)
+
+		results, err = client.GetInbox(true)
+		require.NoError(t, err)
+		assert.Len(t, results, 3)
+	})
+
+	t.Run("TestApproveInbox", func(t *testing.T) {
+		require.NoError(t, client.ApproveInbox(lamp.ThingUID, "Living room lamp"))
+
+		thing, err := client.GetThing(lamp.ThingUID)
+		require.NoError(t, err)
+		assert.Equal(t, "Living room lamp", thing.Label())
+		assert.Equal(t, lamp.BridgeUID, thing.BridgeUID())
+
+		assert.ErrorIs(t, client.ApproveInbox(lamp.ThingUID, ""), ErrNotFound)
+	})
+
+	t.Run("TestIgnoreInbox", func(t *testing.T) {
+		require.NoError(t, client.IgnoreInbox(sensor.ThingUID))
+
+		results, err := client.GetInbox(false)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("TestRemoveInbox", func(t *testing.T) {
+		require.NoError(t, client.RemoveInbox(plug.ThingUID))
+		assert.ErrorIs(t, client.RemoveInbox(plug.ThingUID), ErrNotFound)
+
+		results, err := client.GetInbox(true)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, sensor.ThingUID, results[0].ThingUID)
+	})
+
+	assert.NoError(t, server.InboxErr())
+	assert.NoError(t, server.ThingsErr())
+}
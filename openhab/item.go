@@ -2,6 +2,8 @@ package openhab
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 
 const (
 	itemsPath = "items/"
+	// allMetadata is the query parameter to load the metadata from all the namespaces
+	allMetadata = "?metadata=.*"
 )
 
 // Item represents an item in openHAB
@@ -23,6 +27,7 @@ type Item struct {
 	subType     string
 	client      *Client
 	apiLocker   sync.Mutex
+	dataLocker  sync.Mutex
 	stateLocker sync.Mutex
 	updated     time.Time
 }
@@ -36,16 +41,60 @@ func newItem(client *Client, name string) *Item {
 }
 
 func (i *Item) set(data api.Item) *Item {
+	i.setData(data)
+	i.setInternalStateString(data.State)
+	return i
+}
+
+// setData updates the item definition without changing its state
+func (i *Item) setData(data api.Item) {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
 	i.data = data
+	i.setType()
+}
+
+// setFromEvent updates the item definition from an item registry event.
+// The state is kept as it is not sent with the event.
+func (i *Item) setFromEvent(item event.Item) {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	i.data.Type = item.Type
+	i.data.GroupType = item.GroupType
+	i.data.Label = item.Label
+	i.data.Category = item.Category
+	i.data.Tags = item.Tags
+	i.data.GroupNames = item.GroupNames
+	i.setType()
+}
+
+// setDefinition updates the item definition (as sent to the item registry) without changing its state or metadata
+func (i *Item) setDefinition(data api.Item) {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	i.data.Type = data.Type
+	i.data.GroupType = data.GroupType
+	i.data.Label = data.Label
+	i.data.Category = data.Category
+	i.data.Tags = data.Tags
+	i.data.GroupNames = data.GroupNames
+	i.data.Function = data.Function
+	i.setType()
+}
+
+// setType sets the main type and sub type from the item data.
+// This method is NOT using the dataLocker: it is the responsibility of the caller to do so.
+func (i *Item) setType() {
 	if i.data.Type != "" {
 		i.mainType, i.subType = getItemType(i.data.Type)
-		i.isGroup = false
+		i.isGroup = i.mainType == ItemTypeGroup
 	} else if i.data.GroupType != "" {
 		i.mainType, i.subType = getItemType(i.data.GroupType)
 		i.isGroup = true
 	}
-	i.setInternalStateString(data.State)
-	return i
 }
 
 // load fetches the item data from openHAB. The provided context controls the request
@@ -54,7 +103,7 @@ func (i *Item) load(ctx context.Context) error {
 	data := api.Item{}
 
 	i.client.addCounter(MetricItemLoad, 1, MetricItemName, i.name)
-	err := i.client.getJSON(ctx, itemsPath+i.name, &data)
+	err := i.client.getJSON(ctx, itemsPath+i.name+allMetadata, &data)
 	if err != nil {
 		return err
 	}
@@ -69,16 +118,25 @@ func (i *Item) Name() string {
 
 // Type return the item type
 func (i *Item) Type() ItemType {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
 	return i.mainType
 }
 
 // IsGroup returns true if the item is a group of items
 func (i *Item) IsGroup() bool {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
 	return i.isGroup
 }
 
-// IsMemberOf returns true if the item is a member of the group
+// IsMemberOf returns true if the item is a direct member of the group
 func (i *Item) IsMemberOf(groupName string) bool {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
 	for _, group := range i.data.GroupNames {
 		if group == groupName {
 			return true
@@ -87,8 +145,135 @@ func (i *Item) IsMemberOf(groupName string) bool {
 	return false
 }
 
+// Label returns the label of the item
+func (i *Item) Label() string {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	return i.data.Label
+}
+
+// Category returns the category (icon) of the item
+func (i *Item) Category() string {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	return i.data.Category
+}
+
+// Tags returns a copy of the list of tags attached to the item
+func (i *Item) Tags() []string {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	return copyStrings(i.data.Tags)
+}
+
+// HasTag returns true if the tag is attached to the item (the comparison is case insensitive)
+func (i *Item) HasTag(tag string) bool {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	for _, itemTag := range i.data.Tags {
+		if strings.EqualFold(itemTag, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupNames returns a copy of the list of groups the item is a direct member of
+func (i *Item) GroupNames() []string {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	return copyStrings(i.data.GroupNames)
+}
+
+// SubType returns the dimension of a "Number:<dimension>" item, like "Temperature".
+// It returns an empty string for any other item type.
+func (i *Item) SubType() string {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	return i.subType
+}
+
+// Dimension returns the dimension of a "Number:<dimension>" item, or DimensionNone
+func (i *Item) Dimension() Dimension {
+	return Dimension(i.SubType())
+}
+
+// GroupType returns the type of the items aggregated by a group, like ItemTypeSwitch or ItemTypeNumber.
+// It returns an empty type if the item is not a group, or if the group has no base type.
+func (i *Item) GroupType() ItemType {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	if i.data.GroupType == "" {
+		return ""
+	}
+	groupType, _ := getItemType(i.data.GroupType)
+	return groupType
+}
+
+// GroupFunction returns the aggregation function of a group, like "OR", "AVG" or "SUM".
+// It returns false if the item is not a group, or if the group has no function.
+func (i *Item) GroupFunction() (api.Function, bool) {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	if i.data.Function == nil {
+		return api.Function{}, false
+	}
+	return api.Function{
+		Name:   i.data.Function.Name,
+		Params: copyStrings(i.data.Function.Params),
+	}, true
+}
+
+// TransformedState returns the state of the item after applying the transformation from its state description pattern.
+// It is the value received when the item definition was loaded: it is not updated by state events.
+func (i *Item) TransformedState() string {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	return i.data.TransformedState
+}
+
+// StateDescription returns the state description of the item (minimum, maximum, step, pattern, options...).
+// It returns false if the item has no state description.
+func (i *Item) StateDescription() (api.StateDescription, bool) {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	if i.data.StateDescription == nil {
+		return api.StateDescription{}, false
+	}
+	description := *i.data.StateDescription
+	description.Options = append([]api.StateOptions(nil), description.Options...)
+	return description, true
+}
+
+// CommandDescription returns the list of commands suggested for the item.
+// It returns false if the item has no command description.
+func (i *Item) CommandDescription() (api.CommandDescription, bool) {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	if i.data.CommandDescription == nil {
+		return api.CommandDescription{}, false
+	}
+	return api.CommandDescription{
+		Options: append([]api.CommandOptions(nil), i.data.CommandDescription.Options...),
+	}, true
+}
+
 // Updated returns the last time the item state was updated (doesn't necessarily mean the state was changed)
 func (i *Item) Updated() time.Time {
+	i.stateLocker.Lock()
+	defer i.stateLocker.Unlock()
+
 	return i.updated
 }
 
@@ -133,16 +318,26 @@ func (i *Item) StateContext(ctx context.Context) (State, error) {
 	return value, nil
 }
 
-// SendCommand sends a command to an item
-func (i *Item) SendCommand(command State) error {
+// SendCommand sends a command to an item.
+// It returns an ErrInvalidCommand error if the item type (or the base type of a group) does not accept the command.
+func (i *Item) SendCommand(command Command) error {
 	ctx, cancel := context.WithTimeout(context.Background(), i.client.config.TimeoutHTTP)
 	defer cancel()
 
 	return i.SendCommandContext(ctx, command)
 }
 
-// SendCommandContext sends a command to an item
-func (i *Item) SendCommandContext(ctx context.Context, command State) error {
+// SendCommandContext sends a command to an item.
+// It returns an ErrInvalidCommand error if the item type (or the base type of a group) does not accept the command.
+func (i *Item) SendCommandContext(ctx context.Context, command Command) error {
+	itemType := i.Type()
+	if groupType := i.GroupType(); itemType == ItemTypeGroup && groupType != "" {
+		// the command is sent to the members of the group
+		itemType = groupType
+	}
+	if !itemType.AcceptsCommand(command) {
+		return fmt.Errorf("%w %q for item %q of type %s", ErrInvalidCommand, commandString(command), i.name, itemType)
+	}
 	i.apiLocker.Lock()
 	defer i.apiLocker.Unlock()
 
@@ -156,8 +351,9 @@ func (i *Item) SendCommandContext(ctx context.Context, command State) error {
 
 // SendCommandWait sends a command to an item and wait until the event bus acknowledge receiving the state, or after a timeout
 // It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
-// It returns false in case the acknowledged value is different than the command, or after timeout
-func (i *Item) SendCommandWait(command State, timeout time.Duration) (bool, error) {
+// It returns false in case the acknowledged value is different than the command, or after timeout.
+// A command without an equivalent state (like CommandINCREASE) is acknowledged by any state received.
+func (i *Item) SendCommandWait(command Command, timeout time.Duration) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -166,8 +362,65 @@ func (i *Item) SendCommandWait(command State, timeout time.Duration) (bool, erro
 
 // SendCommandWaitContext sends a command to an item and wait until the event bus acknowledge receiving the state, or after a timeout.
 // It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
-// It returns false in case the acknowledged value is different than the command, or after timeout
-func (i *Item) SendCommandWaitContext(ctx context.Context, command State) (bool, error) {
+// It returns false in case the acknowledged value is different than the command, or after timeout.
+// A command without an equivalent state (like CommandINCREASE) is acknowledged by any state received.
+func (i *Item) SendCommandWaitContext(ctx context.Context, command Command) (bool, error) {
+	return i.waitForState(ctx, command, func() error {
+		return i.SendCommandContext(ctx, command)
+	})
+}
+
+// PostUpdate updates the state of an item without sending a command:
+// the bindings linked to the item are not receiving anything.
+// This is useful for virtual items, or items mirroring a sensor.
+func (i *Item) PostUpdate(state State) error {
+	ctx, cancel := context.WithTimeout(context.Background(), i.client.config.TimeoutHTTP)
+	defer cancel()
+
+	return i.PostUpdateContext(ctx, state)
+}
+
+// PostUpdateContext updates the state of an item without sending a command:
+// the bindings linked to the item are not receiving anything.
+// This is useful for virtual items, or items mirroring a sensor.
+func (i *Item) PostUpdateContext(ctx context.Context, state State) error {
+	if state == nil {
+		return fmt.Errorf("cannot update item %q: missing state", i.name)
+	}
+	i.apiLocker.Lock()
+	defer i.apiLocker.Unlock()
+
+	i.client.addCounter(MetricItemPostUpdate, 1, MetricItemName, i.name)
+	err := i.client.putString(ctx, itemsPath+i.name+"/state", state.String(), nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PostUpdateWait updates the state of an item and wait until the event bus acknowledge receiving the state, or after a timeout
+// It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
+// It returns false in case the acknowledged value is different than the state, or after timeout
+func (i *Item) PostUpdateWait(state State, timeout time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return i.PostUpdateWaitContext(ctx, state)
+}
+
+// PostUpdateWaitContext updates the state of an item and wait until the event bus acknowledge receiving the state, or after a timeout.
+// It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
+// It returns false in case the acknowledged value is different than the state, or after timeout
+func (i *Item) PostUpdateWaitContext(ctx context.Context, state State) (bool, error) {
+	return i.waitForState(ctx, state, func() error {
+		return i.PostUpdateContext(ctx, state)
+	})
+}
+
+// waitForState calls send then waits until the event bus acknowledge receiving a state, or until the context is done.
+// It returns true if the state received is equal to the expected state,
+// or if the expected command has no equivalent state.
+func (i *Item) waitForState(ctx context.Context, expected Command, send func() error) (bool, error) {
 	stateChan := make(chan string, 1)
 	done := make(chan struct{}, 1)
 	subID := i.client.subscribeOnce(i.Name(), event.TypeItemState, func(e event.Event) {
@@ -184,28 +437,46 @@ func (i *Item) SendCommandWaitContext(ctx context.Context, command State) (bool,
 		i.client.unsubscribe(subID)
 	}()
 
-	if err := i.SendCommandContext(ctx, command); err != nil {
+	if err := send(); err != nil {
 		return false, err
 	}
 
 	select {
 	case state := <-stateChan:
-		return command.Equal(state), nil
+		if expectedState, ok := expected.(State); ok {
+			return expectedState.Equal(state), nil
+		}
+		return true, nil
 	case <-ctx.Done():
 		return false, nil
 	}
 }
 
 func (i *Item) stateFromString(state string) State {
-	switch i.mainType {
+	if isUndefinedValue(state) {
+		return UnDefState(state)
+	}
+	switch i.Type() {
 	default:
 		return StringState(state)
 	case ItemTypeSwitch:
 		return SwitchState(state)
 	case ItemTypeNumber:
-		return MustParseDecimalState(state)
+		return MustParseDecimalState(state).withDimension(i.Dimension())
 	case ItemTypeDateTime:
 		return MustParseDateTimeState(state)
+	case ItemTypeColor:
+		return MustParseHSBState(state)
+	case ItemTypeDimmer:
+		return MustParsePercentState(state)
+	case ItemTypeContact:
+		return MustParseOpenClosedState(state)
+	case ItemTypeRollershutter:
+		return MustParseRollershutterState(state)
+	case ItemTypeLocation:
+		return MustParsePointState(state)
+	case ItemTypeImage:
+		return MustParseRawState(state)
 	}
 }
 
@@ -230,3 +501,10 @@ func (i *Item) setInternalState(state State) {
 func (i *Item) setInternalStateString(state string) {
 	i.setInternalState(i.stateFromString(state))
 }
+
+func copyStrings(source []string) []string {
+	if source == nil {
+		return nil
+	}
+	return append(make([]string, 0, len(source)), source...)
+}
@@ -3,9 +3,11 @@ package openhab
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
 )
 
 // itemCollection represents the collection of items in openHAB
@@ -13,6 +15,16 @@ type itemCollection struct {
 	client      *Client
 	cache       map[string]*Item
 	cacheLocker sync.Mutex
+	// members is the cache of the direct members of the groups, invalidated when the items are changing
+	members           map[string][]*Item
+	membersGeneration int
+	membersLocker     sync.Mutex
+	// preloaded are the items loaded before the cache (see findItems): the cache keeps these instances when it is loaded.
+	// It is protected by the cacheLocker.
+	preloaded map[string]*Item
+	// histories are the states kept in memory for the items (see KeepItemHistory)
+	histories       map[string]*stateHistory
+	historiesLocker sync.Mutex
 }
 
 func newItems(client *Client) *itemCollection {
@@ -56,6 +68,68 @@ func (items *itemCollection) removeItem(name string) {
 	defer items.cacheLocker.Unlock()
 
 	delete(items.cache, name)
+	if items.cache != nil {
+		items.client.setGauge(MetricItemsCacheSize, int64(len(items.cache)), "", "")
+	}
+	items.invalidateMembers()
+}
+
+// setItemFromEvent adds or updates the item definition from an item registry event.
+// Nothing is done if the cache is not loaded yet: the item will be loaded with the cache.
+func (items *itemCollection) setItemFromEvent(data event.Item) {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	items.invalidateMembers()
+	if items.cache == nil {
+		return
+	}
+	item, ok := items.cache[data.Name]
+	if !ok {
+		item = newItem(items.client, data.Name)
+		items.cache[data.Name] = item
+		items.client.setGauge(MetricItemsCacheSize, int64(len(items.cache)), "", "")
+	}
+	item.setFromEvent(data)
+}
+
+// setItemDefinition adds or updates the item definition after a call to the item registry.
+// A new item has no state (NULL) until openHAB sends a state event.
+// It returns the item from the cache, or a new item if the cache is not loaded yet.
+func (items *itemCollection) setItemDefinition(data api.Item) *Item {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	items.invalidateMembers()
+	if item, ok := items.cache[data.Name]; ok {
+		item.setDefinition(data)
+		return item
+	}
+	data.State = StateNULL
+	item := newItem(items.client, data.Name).set(data)
+	if items.cache != nil {
+		items.cache[data.Name] = item
+		items.client.setGauge(MetricItemsCacheSize, int64(len(items.cache)), "", "")
+	}
+	return item
+}
+
+// isMemberOf returns true if the item is a direct member of the group.
+// The membership is only resolved from the cache (loaded on the first call):
+// an unknown item is not loaded from openHAB and is not a member.
+func (items *itemCollection) isMemberOf(ctx context.Context, itemName, groupName string) (bool, error) {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	if items.cache == nil {
+		// load them all now
+		err := items.loadCache(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+	item, ok := items.cache[itemName]
+	return ok && item.IsMemberOf(groupName), nil
 }
 
 // getMembersOf returns a list of items member of the group
@@ -80,6 +154,32 @@ func (items *itemCollection) getMembersOf(ctx context.Context, groupName string)
 	return members, nil
 }
 
+// getItems returns all the items sorted by name.
+// If some metadata namespaces are given, only the items having metadata in all these namespaces are returned.
+func (items *itemCollection) getItems(ctx context.Context, metadataNamespaces ...string) ([]*Item, error) {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	if items.cache == nil {
+		// load them all now
+		err := items.loadCache(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	found := make([]*Item, 0, len(items.cache))
+	for _, item := range items.cache {
+		if item.hasAllMetadata(metadataNamespaces) {
+			found = append(found, item)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Name() < found[j].Name()
+	})
+	return found, nil
+}
+
 // refreshCache reloads the items from openHAB and updates the cache.
 // This method is thread safe.
 func (items *itemCollection) refreshCache(ctx context.Context) error {
@@ -89,7 +189,19 @@ func (items *itemCollection) refreshCache(ctx context.Context) error {
 	return items.loadCache(ctx)
 }
 
+// ensureCache loads the cache if it is not loaded yet
+func (items *itemCollection) ensureCache(ctx context.Context) error {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	if items.cache != nil {
+		return nil
+	}
+	return items.loadCache(ctx)
+}
+
 // loadCache loads all items into the cache.
+// The items already loaded are updated in place, so the instances held by the callers stay in sync.
 // This method is NOT using the cacheLocker: it is the responsibility of the caller to do so.
 func (items *itemCollection) loadCache(ctx context.Context) error {
 	all, err := items.load(ctx)
@@ -97,10 +209,21 @@ func (items *itemCollection) loadCache(ctx context.Context) error {
 		return err
 	}
 
-	items.cache = make(map[string]*Item, len(all))
-	for _, item := range all {
-		items.cache[item.Name] = newItem(items.client, item.Name).set(item)
+	cache := make(map[string]*Item, len(all))
+	for _, data := range all {
+		item, ok := items.cache[data.Name]
+		if !ok {
+			item, ok = items.preloaded[data.Name]
+		}
+		if !ok {
+			item = newItem(items.client, data.Name)
+		}
+		cache[data.Name] = item.set(data)
 	}
+	items.cache = cache
+	items.preloaded = nil
+	// the members are pointing to the previous definitions of the items
+	items.invalidateMembers()
 	items.client.setGauge(MetricItemsCacheSize, int64(len(items.cache)), "", "")
 	return nil
 }
@@ -108,7 +231,7 @@ func (items *itemCollection) loadCache(ctx context.Context) error {
 // load all items from the API
 func (items *itemCollection) load(ctx context.Context) ([]api.Item, error) {
 	all := make([]api.Item, 0)
-	err := items.client.getJSON(ctx, "items", &all)
+	err := items.client.getJSON(ctx, "items"+allMetadata, &all)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,246 @@
+package openhab
+
+import (
+	"sync"
+	"time"
+)
+
+// maxItemHistoryCapacity is the maximum number of states kept in memory for an item
+const maxItemHistoryCapacity = 10000
+
+// KeepItemHistory keeps in memory the states received by the item, without the need of a persistence service.
+// The history is bounded by a maximum number of states and/or a maximum age: a zero value means no limit,
+// but no more than 10000 states are kept for an item. Calling it again resizes the history of the item,
+// and calling it with both values set to zero stops keeping the history (and discards it).
+// The history is also discarded when the item is removed from openHAB.
+//
+// The current state of the item is kept as the first state of the history if the item is already loaded.
+func (c *Client) KeepItemHistory(itemName string, maxCount int, maxAge time.Duration) {
+	c.items.keepHistory(itemName, maxCount, maxAge)
+}
+
+// History returns the states received since that time, oldest first, with the state in effect at that time.
+// The history is only available after a call to Client.KeepItemHistory.
+func (i *Item) History(since time.Time) []HistoricState {
+	states := i.client.items.historyOf(i.name)
+	for index := len(states) - 1; index >= 0; index-- {
+		if !states[index].Time.After(since) {
+			return states[index:]
+		}
+	}
+	return states
+}
+
+// StateAt returns the state in effect at that time from the history kept in memory.
+// It returns false if the history is not kept or doesn't go back that far.
+func (i *Item) StateAt(at time.Time) (HistoricState, bool) {
+	states := i.client.items.historyOf(i.name)
+	for index := len(states) - 1; index >= 0; index-- {
+		if !states[index].Time.After(at) {
+			return states[index], true
+		}
+	}
+	return HistoricState{}, false
+}
+
+// PreviousState returns the state before the last change of state, from the history kept in memory.
+// It returns false if the history is not kept or there's no change of state in it.
+func (i *Item) PreviousState() (HistoricState, bool) {
+	states := i.client.items.historyOf(i.name)
+	if len(states) == 0 {
+		return HistoricState{}, false
+	}
+	current := states[len(states)-1].State.String()
+	for index := len(states) - 2; index >= 0; index-- {
+		if states[index].State.String() != current {
+			return states[index], true
+		}
+	}
+	return HistoricState{}, false
+}
+
+// ChangedSince returns true if the state changed since that time, from the history kept in memory.
+func (i *Item) ChangedSince(since time.Time) bool {
+	states := i.History(since)
+	for index := 1; index < len(states); index++ {
+		if states[index].State.String() != states[index-1].State.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// stateHistory is a ring buffer of the states received by an item.
+// The buffer grows on demand, up to its capacity.
+type stateHistory struct {
+	locker   sync.Mutex
+	states   []HistoricState
+	start    int
+	length   int
+	capacity int
+	maxAge   time.Duration
+}
+
+func newStateHistory(maxCount int, maxAge time.Duration) *stateHistory {
+	if maxCount <= 0 || maxCount > maxItemHistoryCapacity {
+		maxCount = maxItemHistoryCapacity
+	}
+	return &stateHistory{
+		capacity: maxCount,
+		maxAge:   maxAge,
+	}
+}
+
+// add the state at the end of the history: the oldest state is dropped when the history is full
+func (h *stateHistory) add(state HistoricState) {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+
+	switch {
+	case h.length < len(h.states):
+		h.states[(h.start+h.length)%len(h.states)] = state
+		h.length++
+	case h.length < h.capacity:
+		// the buffer is full but can grow
+		if h.start > 0 {
+			h.states = h.list()
+			h.start = 0
+		}
+		h.states = append(h.states, state)
+		h.length++
+	default:
+		h.states[h.start] = state
+		h.start = (h.start + 1) % len(h.states)
+	}
+	h.prune(state.Time)
+}
+
+// prune drops the states older than the maximum age, keeping the state in effect at that age.
+// This method is NOT using the locker: it is the responsibility of the caller to do so.
+func (h *stateHistory) prune(now time.Time) {
+	if h.maxAge <= 0 {
+		return
+	}
+	limit := now.Add(-h.maxAge)
+	for h.length > 1 && !h.states[(h.start+1)%len(h.states)].Time.After(limit) {
+		h.states[h.start] = HistoricState{}
+		h.start = (h.start + 1) % len(h.states)
+		h.length--
+	}
+}
+
+// resize keeps the most recent states in a history with a new capacity and maximum age
+func (h *stateHistory) resize(maxCount int, maxAge time.Duration) {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+
+	resized := newStateHistory(maxCount, maxAge)
+	states := h.list()
+	if len(states) > resized.capacity {
+		states = states[len(states)-resized.capacity:]
+	}
+	h.states = states
+	h.start = 0
+	h.length = len(states)
+	h.capacity = resized.capacity
+	h.maxAge = maxAge
+	h.prune(time.Now())
+}
+
+// snapshot returns a copy of the states, oldest first
+func (h *stateHistory) snapshot() []HistoricState {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+
+	h.prune(time.Now())
+	return h.list()
+}
+
+// size returns the number of states in the history
+func (h *stateHistory) size() int {
+	h.locker.Lock()
+	defer h.locker.Unlock()
+
+	return h.length
+}
+
+// list returns a copy of the states, oldest first.
+// This method is NOT using the locker: it is the responsibility of the caller to do so.
+func (h *stateHistory) list() []HistoricState {
+	states := make([]HistoricState, h.length)
+	for index := range states {
+		states[index] = h.states[(h.start+index)%len(h.states)]
+	}
+	return states
+}
+
+func (items *itemCollection) keepHistory(itemName string, maxCount int, maxAge time.Duration) {
+	if maxCount <= 0 && maxAge <= 0 {
+		items.dropHistory(itemName)
+		return
+	}
+	items.historiesLocker.Lock()
+	defer items.historiesLocker.Unlock()
+
+	if history, ok := items.histories[itemName]; ok {
+		history.resize(maxCount, maxAge)
+		items.client.setGauge(MetricItemHistorySize, int64(history.size()), MetricItemName, itemName)
+		return
+	}
+	history := newStateHistory(maxCount, maxAge)
+	if item := items.cachedItem(itemName); item != nil {
+		if state := item.getInternalState(); state != nil {
+			history.add(HistoricState{Time: item.Updated(), State: state})
+		}
+	}
+	if items.histories == nil {
+		items.histories = make(map[string]*stateHistory)
+	}
+	items.histories[itemName] = history
+	items.client.setGauge(MetricItemHistorySize, int64(history.size()), MetricItemName, itemName)
+}
+
+// dropHistory stops keeping the history of the item and discards it
+func (items *itemCollection) dropHistory(itemName string) {
+	items.historiesLocker.Lock()
+	defer items.historiesLocker.Unlock()
+
+	if _, ok := items.histories[itemName]; !ok {
+		return
+	}
+	delete(items.histories, itemName)
+	items.client.setGauge(MetricItemHistorySize, 0, MetricItemName, itemName)
+}
+
+// addHistory adds the state to the history of the item, if the history is kept
+func (items *itemCollection) addHistory(itemName string, state State, at time.Time) {
+	items.historiesLocker.Lock()
+	history, ok := items.histories[itemName]
+	items.historiesLocker.Unlock()
+
+	if !ok {
+		return
+	}
+	history.add(HistoricState{Time: at, State: state})
+	items.client.setGauge(MetricItemHistorySize, int64(history.size()), MetricItemName, itemName)
+}
+
+// historyOf returns a copy of the history of the item, or nil if the history is not kept
+func (items *itemCollection) historyOf(itemName string) []HistoricState {
+	items.historiesLocker.Lock()
+	history, ok := items.histories[itemName]
+	items.historiesLocker.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return history.snapshot()
+}
+
+// cachedItem returns the item from the cache, without loading it
+func (items *itemCollection) cachedItem(name string) *Item {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	return items.cache[name]
+}
@@ -0,0 +1,183 @@
+package openhab
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type gaugeTelemetry struct {
+	gauges map[string]int64
+	locker sync.Mutex
+}
+
+func (t *gaugeTelemetry) RegisterMetrics(metrics []Metric) {}
+func (t *gaugeTelemetry) Close()                           {}
+func (t *gaugeTelemetry) SetGauge(name string, value int64, tags map[string]string) {
+	t.locker.Lock()
+	defer t.locker.Unlock()
+	t.gauges[name+tags[MetricItemName]] = value
+}
+func (t *gaugeTelemetry) AddCounter(name string, value int64, tags map[string]string) {}
+
+func (t *gaugeTelemetry) gauge(name string) int64 {
+	t.locker.Lock()
+	defer t.locker.Unlock()
+	return t.gauges[name]
+}
+
+func historicStrings(states []HistoricState) []string {
+	values := make([]string, len(states))
+	for i, state := range states {
+		values[i] = state.State.String()
+	}
+	return values
+}
+
+func TestStateHistoryCapacity(t *testing.T) {
+	t.Parallel()
+	start := time.Now()
+	history := newStateHistory(3, 0)
+	for i := 0; i < 5; i++ {
+		history.add(HistoricState{Time: start.Add(time.Duration(i) * time.Second), State: NewDecimalState(float64(i), "")})
+	}
+	assert.Equal(t, []string{"2", "3", "4"}, historicStrings(history.snapshot()))
+
+	history.resize(2, 0)
+	assert.Equal(t, []string{"3", "4"}, historicStrings(history.snapshot()))
+
+	history.resize(5, 0)
+	history.add(HistoricState{Time: start.Add(5 * time.Second), State: NewDecimalState(5, "")})
+	assert.Equal(t, []string{"3", "4", "5"}, historicStrings(history.snapshot()))
+	assert.Equal(t, 3, history.size())
+}
+
+func TestStateHistoryMaxAge(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	history := newStateHistory(0, time.Minute)
+	assert.Equal(t, maxItemHistoryCapacity, history.capacity)
+	assert.Empty(t, history.states)
+
+	history.add(HistoricState{Time: now.Add(-3 * time.Minute), State: SwitchON})
+	history.add(HistoricState{Time: now.Add(-2 * time.Minute), State: SwitchOFF})
+	history.add(HistoricState{Time: now.Add(-30 * time.Second), State: SwitchON})
+	// the state in effect one minute ago is kept
+	assert.Equal(t, []string{"OFF", "ON"}, historicStrings(history.snapshot()))
+}
+
+func TestStateHistoryGrowsOnDemand(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	history := newStateHistory(4, time.Minute)
+	history.add(HistoricState{Time: now.Add(-3 * time.Minute), State: NewDecimalState(1, "")})
+	history.add(HistoricState{Time: now.Add(-2 * time.Minute), State: NewDecimalState(2, "")})
+	history.add(HistoricState{Time: now.Add(-40 * time.Second), State: NewDecimalState(3, "")})
+	// the first state is pruned: the free slot is reused before growing
+	assert.Len(t, history.states, 3)
+	history.add(HistoricState{Time: now.Add(-30 * time.Second), State: NewDecimalState(4, "")})
+	assert.Len(t, history.states, 3)
+	history.add(HistoricState{Time: now.Add(-20 * time.Second), State: NewDecimalState(5, "")})
+	assert.Len(t, history.states, 4)
+	history.add(HistoricState{Time: now.Add(-10 * time.Second), State: NewDecimalState(6, "")})
+	assert.Len(t, history.states, 4)
+	assert.Equal(t, []string{"3", "4", "5", "6"}, historicStrings(history.snapshot()))
+}
+
+func TestItemHistory(t *testing.T) {
+	t.Parallel()
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+	require.NoError(t, server.SetItem(api.Item{Name: "Temperature", Type: "Number:Temperature", State: "20 °C"}))
+	require.NoError(t, server.SetItem(api.Item{Name: "Light", Type: "Switch", State: "OFF"}))
+
+	telemetry := &gaugeTelemetry{gauges: make(map[string]int64)}
+	client := NewClient(Config{URL: server.URL(), Telemetry: telemetry})
+	client.addInternalRules()
+
+	item, err := client.GetItem("Temperature")
+	require.NoError(t, err)
+
+	// not kept yet
+	assert.Empty(t, item.History(time.Time{}))
+	_, ok := item.PreviousState()
+	assert.False(t, ok)
+
+	client.KeepItemHistory("Temperature", 3, time.Hour)
+	start := time.Now()
+	time.Sleep(time.Millisecond)
+
+	for _, state := range []string{"20 °C", "21 °C", "21 °C"} {
+		client.systemEventBus.Publish(event.NewItemReceivedState("Temperature", "Quantity", state))
+		client.systemEventBus.Wait()
+	}
+	// not kept
+	client.systemEventBus.Publish(event.NewItemReceivedState("Light", "OnOff", "ON"))
+	client.systemEventBus.Wait()
+
+	// the initial state is dropped from the ring buffer
+	assert.Equal(t, []string{"20 °C", "21 °C", "21 °C"}, historicStrings(item.History(time.Time{})))
+	assert.Equal(t, []string{"20 °C", "21 °C", "21 °C"}, historicStrings(item.History(start)))
+
+	previous, ok := item.PreviousState()
+	require.True(t, ok)
+	assert.Equal(t, MustParseDecimalState("20 °C"), previous.State)
+
+	assert.True(t, item.ChangedSince(start))
+	assert.False(t, item.ChangedSince(time.Now()))
+
+	state, ok := item.StateAt(time.Now())
+	require.True(t, ok)
+	assert.Equal(t, MustParseDecimalState("21 °C"), state.State)
+	_, ok = item.StateAt(start.Add(-time.Hour))
+	assert.False(t, ok)
+
+	light, err := client.GetItem("Light")
+	require.NoError(t, err)
+	assert.Empty(t, light.History(time.Time{}))
+
+	client.telemetryWg.Wait()
+	assert.Equal(t, int64(3), telemetry.gauge(MetricItemHistorySize+"Temperature"))
+
+	client.KeepItemHistory("Temperature", 0, 0)
+	assert.Empty(t, item.History(time.Time{}))
+	client.telemetryWg.Wait()
+	assert.Equal(t, int64(0), telemetry.gauge(MetricItemHistorySize+"Temperature"))
+}
+
+func TestItemHistoryDroppedWhenItemRemoved(t *testing.T) {
+	t.Parallel()
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+	require.NoError(t, server.SetItem(api.Item{Name: "Light", Type: "Switch", State: "OFF"}))
+
+	telemetry := &gaugeTelemetry{gauges: make(map[string]int64)}
+	client := NewClient(Config{URL: server.URL(), Telemetry: telemetry})
+	client.addInternalRules()
+
+	item, err := client.GetItem("Light")
+	require.NoError(t, err)
+	client.KeepItemHistory("Light", 10, 0)
+	client.systemEventBus.Publish(event.NewItemReceivedState("Light", "OnOff", "ON"))
+	client.systemEventBus.Wait()
+	assert.Equal(t, []string{"OFF", "ON"}, historicStrings(item.History(time.Time{})))
+	client.telemetryWg.Wait()
+	assert.Equal(t, int64(2), telemetry.gauge(MetricItemHistorySize+"Light"))
+
+	client.systemEventBus.Publish(event.NewItemRemoved(event.Item{Name: "Light", Type: "Switch"}))
+	client.systemEventBus.Wait()
+	assert.Empty(t, item.History(time.Time{}))
+	client.telemetryWg.Wait()
+	assert.Equal(t, int64(0), telemetry.gauge(MetricItemHistorySize+"Light"))
+
+	// a new item with the same name starts without history
+	client.systemEventBus.Publish(event.NewItemReceivedState("Light", "OnOff", "OFF"))
+	client.systemEventBus.Wait()
+	assert.Empty(t, item.History(time.Time{}))
+}
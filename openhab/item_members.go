@@ -0,0 +1,187 @@
+package openhab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+// SkipGroup can be returned by a WalkGroupFunc to skip the members of the group item being visited.
+var SkipGroup = errors.New("skip this group")
+
+// WalkGroupFunc is called by WalkGroup for each member of the group hierarchy.
+// The depth of the direct members of the group is 1.
+// Returning SkipGroup on a group item skips its members, any other error stops the walk.
+type WalkGroupFunc func(item *Item, depth int) error
+
+// Members returns the members of the group item, sorted by name.
+// If recursive, the members of all the sub-groups are also returned (with the sub-groups themselves).
+// An item member of more than one group of the hierarchy is only returned once,
+// and a cycle in the group hierarchy is not followed more than once.
+//
+// The group hierarchy is loaded from openHAB with a single request, then cached
+// until an item is added, updated or removed.
+func (i *Item) Members(ctx context.Context, recursive bool) ([]*Item, error) {
+	members, err := i.client.items.collectMembers(ctx, i.name, recursive)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name() < members[j].Name()
+	})
+	return members, nil
+}
+
+// WalkGroup walks the group hierarchy depth first, calling walk for each member of the group and of its sub-groups.
+// The members of a group are visited in name order. Each item is only visited once, even if it is a member
+// of more than one group, which also protects against cycles in the group hierarchy.
+// It returns the error returned by walk, except SkipGroup.
+func (c *Client) WalkGroup(ctx context.Context, groupName string, walk WalkGroupFunc) error {
+	return c.items.walkGroup(ctx, groupName, walk)
+}
+
+// collectMembers returns the members of the group, and the members of its sub-groups if recursive, in walk order
+func (items *itemCollection) collectMembers(ctx context.Context, groupName string, recursive bool) ([]*Item, error) {
+	members := make([]*Item, 0)
+	walk := func(item *Item, depth int) error {
+		members = append(members, item)
+		if !recursive {
+			return SkipGroup
+		}
+		return nil
+	}
+	err := items.walkGroup(ctx, groupName, walk)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (items *itemCollection) walkGroup(ctx context.Context, groupName string, walk WalkGroupFunc) error {
+	visited := map[string]bool{groupName: true}
+	err := items.walkMembers(ctx, groupName, 1, visited, walk)
+	if errors.Is(err, SkipGroup) {
+		return nil
+	}
+	return err
+}
+
+func (items *itemCollection) walkMembers(ctx context.Context, groupName string, depth int, visited map[string]bool, walk WalkGroupFunc) error {
+	members, err := items.groupMembers(ctx, groupName)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		if visited[member.Name()] {
+			continue
+		}
+		visited[member.Name()] = true
+		err = walk(member, depth)
+		if errors.Is(err, SkipGroup) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !member.IsGroup() {
+			continue
+		}
+		err = items.walkMembers(ctx, member.Name(), depth+1, visited, walk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupMembers returns the direct members of the group, sorted by name.
+// The whole hierarchy under the group is loaded into the members cache on the first call.
+// The items cache is loaded first so the members are the items kept up to date by the events.
+func (items *itemCollection) groupMembers(ctx context.Context, groupName string) ([]*Item, error) {
+	err := items.ensureCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items.membersLocker.Lock()
+	members, ok := items.members[groupName]
+	generation := items.membersGeneration
+	items.membersLocker.Unlock()
+
+	if ok {
+		return members, nil
+	}
+
+	data := api.Item{}
+	items.client.addCounter(MetricItemLoad, 1, MetricItemName, groupName)
+	err = items.client.getJSON(ctx, itemsPath+groupName+allMetadata+"&recursive=true", &data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load members of group %q: %w", groupName, err)
+	}
+	loaded := make(map[string][]*Item)
+	items.loadMembers(data, loaded)
+
+	items.membersLocker.Lock()
+	defer items.membersLocker.Unlock()
+
+	// don't keep the members if the items changed in the meantime
+	if generation == items.membersGeneration {
+		if items.members == nil {
+			items.members = make(map[string][]*Item, len(loaded))
+		}
+		for name, members := range loaded {
+			items.members[name] = members
+		}
+	}
+	return loaded[groupName], nil
+}
+
+// loadMembers adds the direct members of the group, and of all the sub-groups found in the hierarchy.
+// A group found more than once in the hierarchy is only loaded the first time.
+func (items *itemCollection) loadMembers(group api.Item, loaded map[string][]*Item) {
+	if _, ok := loaded[group.Name]; ok {
+		return
+	}
+	members := make([]*Item, len(group.Members))
+	loaded[group.Name] = members
+	for i, member := range group.Members {
+		if member.Type == string(ItemTypeGroup) {
+			items.loadMembers(*member, loaded)
+		}
+		members[i] = items.itemFromData(*member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name() < members[j].Name()
+	})
+}
+
+// itemFromData returns the item from the cache, or a new item from the data added to the cache
+func (items *itemCollection) itemFromData(data api.Item) *Item {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	if item, ok := items.cache[data.Name]; ok {
+		items.client.addCounter(MetricItemCacheHit, 1, MetricItemName, data.Name)
+		return item
+	}
+	data.Members = nil
+	item := newItem(items.client, data.Name).set(data)
+	if items.cache != nil {
+		items.cache[data.Name] = item
+		items.client.setGauge(MetricItemsCacheSize, int64(len(items.cache)), "", "")
+	}
+	return item
+}
+
+// invalidateMembers clears the members cache.
+// This method can be called while holding the cacheLocker.
+func (items *itemCollection) invalidateMembers() {
+	items.membersLocker.Lock()
+	defer items.membersLocker.Unlock()
+
+	items.members = nil
+	items.membersGeneration++
+}
@@ -0,0 +1,211 @@
+package openhab
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// groupTreeItems are the items loaded into the mock server of the group members tests
+var groupTreeItems = []api.Item{
+	{Name: "House", Type: "Group"},
+	{Name: "GroundFloor", Type: "Group", GroupNames: []string{"House"}},
+	{Name: "FirstFloor", Type: "Group", GroupNames: []string{"House"}},
+	{Name: "Kitchen", Type: "Group", GroupNames: []string{"GroundFloor"}},
+	{Name: "Bedroom", Type: "Group", GroupNames: []string{"FirstFloor"}},
+	{Name: "Lamp", Type: "Group", GroupNames: []string{"Bedroom"}},
+	{Name: "Lamp_Power", Type: "Switch", State: "ON", GroupNames: []string{"Lamp"}},
+	{Name: "Light_Kitchen", Type: "Switch", State: "ON", GroupNames: []string{"Kitchen"}},
+	// member of two rooms
+	{Name: "Light_Stairs", Type: "Switch", State: "OFF", GroupNames: []string{"Kitchen", "Bedroom"}},
+	// cycle in the group hierarchy
+	{Name: "GroupA", Type: "Group", GroupNames: []string{"GroupB"}},
+	{Name: "GroupB", Type: "Group", GroupNames: []string{"GroupA"}},
+	{Name: "Light_Cycle", Type: "Switch", GroupNames: []string{"GroupB"}},
+}
+
+func TestItemMembers(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, groupTreeItems...)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+	house, err := client.GetItem("House")
+	require.NoError(t, err)
+	assert.True(t, house.IsGroup())
+
+	members, err := house.Members(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FirstFloor", "GroundFloor"}, itemNames(members))
+
+	members, err = house.Members(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bedroom", "FirstFloor", "GroundFloor", "Kitchen", "Lamp", "Lamp_Power", "Light_Kitchen", "Light_Stairs"}, itemNames(members))
+
+	// members are the items from the cache
+	light, err := client.GetItem("Light_Kitchen")
+	require.NoError(t, err)
+	assert.Contains(t, members, light)
+
+	groupA, err := client.GetItem("GroupA")
+	require.NoError(t, err)
+	members, err = groupA.Members(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GroupB", "Light_Cycle"}, itemNames(members))
+
+	light, err = client.GetItem("Light_Cycle")
+	require.NoError(t, err)
+	members, err = light.Members(context.Background(), true)
+	require.NoError(t, err)
+	assert.Empty(t, members)
+
+	assert.NoError(t, server.ItemsErr())
+}
+
+func TestItemMembersNotFound(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, groupTreeItems...)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+	_, err := newItem(client, "Unknown").Members(context.Background(), true)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestWalkGroup(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, groupTreeItems...)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+
+	type visit struct {
+		name  string
+		depth int
+	}
+	visits := make([]visit, 0)
+	err := client.WalkGroup(context.Background(), "House", func(item *Item, depth int) error {
+		visits = append(visits, visit{item.Name(), depth})
+		if item.Name() == "Lamp" {
+			return SkipGroup
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []visit{
+		{"FirstFloor", 1},
+		{"Bedroom", 2},
+		{"Lamp", 3},
+		{"Light_Stairs", 3},
+		{"GroundFloor", 1},
+		{"Kitchen", 2},
+		{"Light_Kitchen", 3},
+	}, visits)
+
+	errStop := errors.New("stop")
+	count := 0
+	err = client.WalkGroup(context.Background(), "House", func(item *Item, depth int) error {
+		count++
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, count)
+
+	assert.NoError(t, server.ItemsErr())
+}
+
+func TestItemMembersCacheInvalidation(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, groupTreeItems...)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+	client.addInternalRules()
+
+	kitchen, err := client.GetItem("Kitchen")
+	require.NoError(t, err)
+	members, err := kitchen.Members(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Light_Kitchen", "Light_Stairs"}, itemNames(members))
+
+	// the members are cached
+	require.NoError(t, server.SetItem(api.Item{Name: "Oven", Type: "Switch", State: "OFF", GroupNames: []string{"Kitchen"}}))
+	members, err = kitchen.Members(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Light_Kitchen", "Light_Stairs"}, itemNames(members))
+
+	fixtures := []struct {
+		name     string
+		event    event.Event
+		expected []string
+	}{
+		{
+			"added",
+			event.NewItemAdded(event.Item{Name: "Oven", Type: "Switch", GroupNames: []string{"Kitchen"}}),
+			[]string{"Light_Kitchen", "Light_Stairs", "Oven"},
+		},
+		{
+			"removed",
+			event.NewItemRemoved(event.Item{Name: "Light_Kitchen", Type: "Switch", GroupNames: []string{"Kitchen"}}),
+			[]string{"Light_Stairs", "Oven"},
+		},
+		{
+			"updated",
+			event.NewItemUpdated(
+				event.Item{Name: "Light_Stairs", Type: "Switch", GroupNames: []string{"Kitchen", "Bedroom"}},
+				event.Item{Name: "Light_Stairs", Type: "Switch", GroupNames: []string{"Bedroom"}},
+			),
+			[]string{"Oven"},
+		},
+	}
+	for _, fixture := range fixtures {
+		switch ev := fixture.event.(type) {
+		case event.ItemRemoved:
+			require.NoError(t, server.RemoveItem(ev.Item.Name))
+		case event.ItemUpdated:
+			require.NoError(t, server.SetItem(api.Item{Name: ev.Item.Name, Type: ev.Item.Type, GroupNames: ev.Item.GroupNames}))
+		}
+		client.systemEventBus.Publish(fixture.event)
+		client.systemEventBus.Wait()
+
+		members, err = kitchen.Members(context.Background(), false)
+		require.NoError(t, err, fixture.name)
+		assert.Equal(t, fixture.expected, itemNames(members), fixture.name)
+	}
+
+	assert.NoError(t, server.ItemsErr())
+}
+
+func TestItemMembersWithoutCache(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, groupTreeItems...)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+	client.addInternalRules()
+
+	members := make([]*Item, 0)
+	err := client.WalkGroup(context.Background(), "Kitchen", func(item *Item, depth int) error {
+		members = append(members, item)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Light_Kitchen", "Light_Stairs"}, itemNames(members))
+
+	// the members are the items from the cache
+	light, err := client.GetItem("Light_Kitchen")
+	require.NoError(t, err)
+	assert.Same(t, light, members[0])
+
+	// and they are receiving the state updates
+	client.systemEventBus.Publish(event.NewItemReceivedState("Light_Kitchen", "OnOff", "OFF"))
+	client.systemEventBus.Wait()
+	assert.Equal(t, SwitchOFF, members[0].getInternalState())
+
+	assert.NoError(t, server.ItemsErr())
+}
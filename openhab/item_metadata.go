@@ -0,0 +1,101 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+// Metadata returns the item metadata in the namespace (like "expire", "alexa", "homekit" or any custom namespace).
+// It returns false if the item has no metadata in this namespace.
+//
+// The metadata is loaded with the item definition and kept in the cache:
+// changes made outside of gopenhab are only visible after the cache is refreshed.
+func (i *Item) Metadata(namespace string) (api.Metadata, bool) {
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	metadata, ok := i.data.Metadata[namespace]
+	return metadata, ok
+}
+
+// HasMetadata returns true if the item has metadata in the namespace
+func (i *Item) HasMetadata(namespace string) bool {
+	_, ok := i.Metadata(namespace)
+	return ok
+}
+
+// hasAllMetadata returns true if the item has metadata in all the namespaces
+func (i *Item) hasAllMetadata(namespaces []string) bool {
+	for _, namespace := range namespaces {
+		if !i.HasMetadata(namespace) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetMetadata adds or replaces the item metadata in the namespace
+func (i *Item) SetMetadata(namespace string, metadata api.Metadata) error {
+	ctx, cancel := context.WithTimeout(context.Background(), i.client.config.TimeoutHTTP)
+	defer cancel()
+
+	return i.SetMetadataContext(ctx, namespace, metadata)
+}
+
+// SetMetadataContext adds or replaces the item metadata in the namespace
+func (i *Item) SetMetadataContext(ctx context.Context, namespace string, metadata api.Metadata) error {
+	err := i.client.putJSON(ctx, i.metadataPath(namespace), metadata, nil)
+	if err != nil {
+		return fmt.Errorf("cannot set metadata %q of item %q: %w", namespace, i.name, err)
+	}
+
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	// copy the map so the previous item data can still be read safely
+	all := make(map[string]api.Metadata, len(i.data.Metadata)+1)
+	for key, value := range i.data.Metadata {
+		all[key] = value
+	}
+	all[namespace] = metadata
+	i.data.Metadata = all
+	return nil
+}
+
+// DeleteMetadata removes the item metadata in the namespace.
+// It returns an ErrNotFound error if the item has no metadata in this namespace.
+func (i *Item) DeleteMetadata(namespace string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), i.client.config.TimeoutHTTP)
+	defer cancel()
+
+	return i.DeleteMetadataContext(ctx, namespace)
+}
+
+// DeleteMetadataContext removes the item metadata in the namespace.
+// It returns an ErrNotFound error if the item has no metadata in this namespace.
+func (i *Item) DeleteMetadataContext(ctx context.Context, namespace string) error {
+	err := i.client.send(ctx, http.MethodDelete, i.metadataPath(namespace), "", http.NoBody, nil)
+	if err != nil {
+		return fmt.Errorf("cannot delete metadata %q of item %q: %w", namespace, i.name, err)
+	}
+
+	i.dataLocker.Lock()
+	defer i.dataLocker.Unlock()
+
+	all := make(map[string]api.Metadata, len(i.data.Metadata))
+	for key, value := range i.data.Metadata {
+		if key != namespace {
+			all[key] = value
+		}
+	}
+	i.data.Metadata = all
+	return nil
+}
+
+func (i *Item) metadataPath(namespace string) string {
+	return itemsPath + i.name + "/metadata/" + url.PathEscape(namespace)
+}
@@ -0,0 +1,101 @@
+package openhab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemMetadataAPI(t *testing.T) {
+	t.Parallel()
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetItem(api.Item{
+		Name:  "Heating_Kitchen",
+		Type:  "Switch",
+		State: "OFF",
+		Metadata: map[string]api.Metadata{
+			"expire":  {Value: "10m,command=OFF"},
+			"heating": {Value: "zone", Config: map[string]any{"zone": "kitchen", "priority": float64(2)}},
+		},
+	}))
+	require.NoError(t, server.SetItem(api.Item{
+		Name:     "Heating_Lounge",
+		Type:     "Switch",
+		State:    "OFF",
+		Metadata: map[string]api.Metadata{"heating": {Value: "zone", Config: map[string]any{"zone": "lounge"}}},
+	}))
+	require.NoError(t, server.SetItem(api.Item{Name: "Light_Kitchen", Type: "Switch", State: "OFF"}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	t.Run("TestGetItemsByMetadata", func(t *testing.T) {
+		items, err := client.GetItems()
+		require.NoError(t, err)
+		assert.Len(t, items, 3)
+
+		items, err = client.GetItems("heating")
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, "Heating_Kitchen", items[0].Name())
+		assert.Equal(t, "Heating_Lounge", items[1].Name())
+
+		items, err = client.GetItems("heating", "expire")
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "Heating_Kitchen", items[0].Name())
+
+		items, err = client.GetItems("unknown")
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+
+	t.Run("TestReadMetadata", func(t *testing.T) {
+		item, err := client.GetItem("Heating_Kitchen")
+		require.NoError(t, err)
+
+		metadata, ok := item.Metadata("heating")
+		require.True(t, ok)
+		assert.Equal(t, "zone", metadata.Value)
+		assert.Equal(t, "kitchen", metadata.Config["zone"])
+		assert.Equal(t, float64(2), metadata.Config["priority"])
+
+		_, ok = item.Metadata("alexa")
+		assert.False(t, ok)
+	})
+
+	t.Run("TestSetAndDeleteMetadata", func(t *testing.T) {
+		item, err := client.GetItem("Light_Kitchen")
+		require.NoError(t, err)
+		assert.False(t, item.HasMetadata("homekit"))
+
+		err = item.SetMetadata("homekit", api.Metadata{Value: "Lighting"})
+		require.NoError(t, err)
+		assert.True(t, item.HasMetadata("homekit"))
+
+		// load a fresh copy from the server
+		fresh := newItem(client, "Light_Kitchen")
+		require.NoError(t, fresh.load(context.Background()))
+		metadata, ok := fresh.Metadata("homekit")
+		require.True(t, ok)
+		assert.Equal(t, "Lighting", metadata.Value)
+
+		err = item.DeleteMetadata("homekit")
+		require.NoError(t, err)
+		assert.False(t, item.HasMetadata("homekit"))
+
+		err = item.DeleteMetadata("homekit")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		unknown := newItem(client, "UnknownItem")
+		err = unknown.SetMetadata("homekit", api.Metadata{Value: "Lighting"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	assert.NoError(t, server.ItemsErr())
+}
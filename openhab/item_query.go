@@ -0,0 +1,154 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+)
+
+// ItemQuery selects items with FindItems. Empty fields are ignored: an empty query returns all the items.
+type ItemQuery struct {
+	// Type of the item, like ItemTypeSwitch or ItemTypeNumber (a "Number:Temperature" item is an ItemTypeNumber)
+	Type ItemType
+	// Tags attached to the item: the item must have all of them (case insensitive)
+	Tags []string
+	// Group the item is a member of
+	Group string
+	// Recursive also selects the members of the sub-groups of Group
+	Recursive bool
+	// Name is an exact name, a glob pattern or a regular expression surrounded by slashes (see event.Pattern)
+	Name string
+	// MetadataNamespace selects the items having metadata in this namespace
+	MetadataNamespace string
+	// Label selects the items with a label containing this text (case insensitive)
+	Label string
+}
+
+// FindItems returns the items matching the query, sorted by name.
+//
+// The query runs against the items cache. If the cache is not loaded yet and the query doesn't need
+// the group hierarchy, the type and tags filters are sent to openHAB to only load the matching items:
+// the cache keeps the same instances when it is loaded.
+// The members of a group are found with the same group hierarchy as Item.Members: the group itself
+// is never returned, even when there's a cycle in the hierarchy.
+// It returns an error if the name pattern is malformed.
+func (c *Client) FindItems(ctx context.Context, query ItemQuery) ([]*Item, error) {
+	return c.items.findItems(ctx, query)
+}
+
+func (items *itemCollection) findItems(ctx context.Context, query ItemQuery) ([]*Item, error) {
+	filter, err := newItemFilter(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*Item
+	if query.Group != "" {
+		candidates, err = items.collectMembers(ctx, query.Group, query.Recursive)
+	} else if (query.Type != "" && query.Type != ItemTypeNumber) || len(query.Tags) > 0 {
+		candidates, err = items.getFiltered(ctx, query)
+	} else {
+		candidates, err = items.getItems(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*Item, 0)
+	for _, item := range candidates {
+		if filter.match(item) {
+			found = append(found, item)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Name() < found[j].Name()
+	})
+	return found, nil
+}
+
+// getFiltered returns the items of the type and tags. When the cache is not loaded yet,
+// only the matching items are loaded from the API and kept until the cache is loaded.
+func (items *itemCollection) getFiltered(ctx context.Context, query ItemQuery) ([]*Item, error) {
+	items.cacheLocker.Lock()
+	defer items.cacheLocker.Unlock()
+
+	if items.cache != nil {
+		found := make([]*Item, 0, len(items.cache))
+		for _, item := range items.cache {
+			found = append(found, item)
+		}
+		return found, nil
+	}
+
+	params := url.Values{}
+	params.Set("metadata", ".*")
+	// the type filter is an exact match in openHAB: it would not return the Number items with a dimension
+	if query.Type != "" && query.Type != ItemTypeNumber {
+		params.Set("type", string(query.Type))
+	}
+	if len(query.Tags) > 0 {
+		params.Set("tags", strings.Join(query.Tags, ","))
+	}
+	all := make([]api.Item, 0)
+	err := items.client.getJSON(ctx, "items?"+params.Encode(), &all)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load items: %w", err)
+	}
+	if items.preloaded == nil {
+		items.preloaded = make(map[string]*Item, len(all))
+	}
+	found := make([]*Item, len(all))
+	for index, data := range all {
+		item, ok := items.preloaded[data.Name]
+		if !ok {
+			item = newItem(items.client, data.Name)
+			items.preloaded[data.Name] = item
+		}
+		found[index] = item.set(data)
+	}
+	return found, nil
+}
+
+type itemFilter struct {
+	query ItemQuery
+	name  event.Pattern
+	label string
+}
+
+func newItemFilter(query ItemQuery) (itemFilter, error) {
+	name, err := event.CompilePattern(query.Name)
+	if err != nil {
+		return itemFilter{}, fmt.Errorf("invalid item name pattern %q: %w", query.Name, err)
+	}
+	return itemFilter{
+		query: query,
+		name:  name,
+		label: strings.ToLower(query.Label),
+	}, nil
+}
+
+func (f itemFilter) match(item *Item) bool {
+	if !f.name.Match(item.Name()) {
+		return false
+	}
+	if f.query.Type != "" && item.Type() != f.query.Type {
+		return false
+	}
+	for _, tag := range f.query.Tags {
+		if !item.HasTag(tag) {
+			return false
+		}
+	}
+	if f.query.MetadataNamespace != "" && !item.HasMetadata(f.query.MetadataNamespace) {
+		return false
+	}
+	if f.label != "" && !strings.Contains(strings.ToLower(item.Label()), f.label) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+package openhab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// queryItems are the items loaded into the mock server of the FindItems tests
+var queryItems = []api.Item{
+	{Name: "Home", Type: "Group"},
+	{Name: "FirstFloor", Type: "Group", Label: "First floor", GroupNames: []string{"Home"}},
+	{Name: "Kitchen", Type: "Group", Label: "Kitchen", GroupNames: []string{"FirstFloor"}},
+	{Name: "Light_Kitchen", Type: "Switch", Label: "Kitchen ceiling light", Tags: []string{"Lighting"}, GroupNames: []string{"Kitchen"}, State: "OFF"},
+	{Name: "Temperature_Kitchen", Type: "Number:Temperature", Label: "Kitchen temperature", Tags: []string{"Measurement", "Temperature"}, GroupNames: []string{"Kitchen"}, State: "21 °C",
+		Metadata: map[string]api.Metadata{"heating": {Value: "zone"}}},
+	{Name: "Light_Hallway", Type: "Switch", Label: "Hallway light", Tags: []string{"Lighting"}, GroupNames: []string{"FirstFloor"}, State: "ON"},
+	{Name: "Light_Garden", Type: "Switch", Label: "Garden light", Tags: []string{"Lighting"}, State: "OFF"},
+	{Name: "Counter", Type: "Number", State: "3"},
+	// cycle in the group hierarchy
+	{Name: "GroupA", Type: "Group", GroupNames: []string{"GroupB"}},
+	{Name: "GroupB", Type: "Group", GroupNames: []string{"GroupA"}},
+	{Name: "Light_Cycle", Type: "Switch", GroupNames: []string{"GroupB"}},
+}
+
+func itemNames(items []*Item) []string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.Name()
+	}
+	return names
+}
+
+func TestFindItems(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, queryItems...)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+
+	fixtures := []struct {
+		name     string
+		query    ItemQuery
+		expected []string
+	}{
+		{"type", ItemQuery{Type: ItemTypeSwitch, Name: "Light_*"}, []string{"Light_Cycle", "Light_Garden", "Light_Hallway", "Light_Kitchen"}},
+		{"number with dimension", ItemQuery{Type: ItemTypeNumber}, []string{"Counter", "Temperature_Kitchen"}},
+		{"tags", ItemQuery{Tags: []string{"lighting"}}, []string{"Light_Garden", "Light_Hallway", "Light_Kitchen"}},
+		{"all tags", ItemQuery{Tags: []string{"Measurement", "Temperature"}}, []string{"Temperature_Kitchen"}},
+		{"direct group", ItemQuery{Group: "FirstFloor"}, []string{"Kitchen", "Light_Hallway"}},
+		{"recursive group", ItemQuery{Group: "FirstFloor", Recursive: true}, []string{"Kitchen", "Light_Hallway", "Light_Kitchen", "Temperature_Kitchen"}},
+		{"recursive group with tags", ItemQuery{Group: "Home", Recursive: true, Tags: []string{"Lighting"}}, []string{"Light_Hallway", "Light_Kitchen"}},
+		{"group cycle", ItemQuery{Group: "GroupA", Recursive: true}, []string{"GroupB", "Light_Cycle"}},
+		{"regex name", ItemQuery{Name: "/^Light_(Kitchen|Garden)$/"}, []string{"Light_Garden", "Light_Kitchen"}},
+		{"metadata", ItemQuery{MetadataNamespace: "heating"}, []string{"Temperature_Kitchen"}},
+		{"label", ItemQuery{Label: "kitchen"}, []string{"Kitchen", "Light_Kitchen", "Temperature_Kitchen"}},
+		{"no match", ItemQuery{Type: ItemTypeDimmer}, []string{}},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			items, err := client.FindItems(context.Background(), fixture.query)
+			require.NoError(t, err)
+			assert.Equal(t, fixture.expected, itemNames(items))
+		})
+	}
+
+	_, err := client.FindItems(context.Background(), ItemQuery{Name: "/[/"})
+	assert.Error(t, err)
+
+	assert.NoError(t, server.ItemsErr())
+}
+
+func TestFindItemsWithoutCache(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, queryItems...)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+	client.addInternalRules()
+
+	items, err := client.FindItems(context.Background(), ItemQuery{Type: ItemTypeSwitch, Tags: []string{"Lighting"}, Label: "light"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Light_Garden", "Light_Hallway", "Light_Kitchen"}, itemNames(items))
+
+	// only the items filtered by openHAB are loaded
+	client.items.cacheLocker.Lock()
+	assert.Nil(t, client.items.cache)
+	assert.Len(t, client.items.preloaded, 3)
+	client.items.cacheLocker.Unlock()
+
+	// the items found are the items from the cache
+	light, err := client.GetItem("Light_Garden")
+	require.NoError(t, err)
+	assert.Same(t, light, items[0])
+
+	// even after a refresh
+	require.NoError(t, client.RefreshCache())
+	light, err = client.GetItem("Light_Garden")
+	require.NoError(t, err)
+	assert.Same(t, light, items[0])
+
+	// and they are receiving the state updates
+	client.systemEventBus.Publish(event.NewItemReceivedState("Light_Garden", "OnOff", "ON"))
+	client.systemEventBus.Wait()
+	assert.Equal(t, SwitchON, items[0].getInternalState())
+
+	assert.NoError(t, server.ItemsErr())
+}
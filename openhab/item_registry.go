@@ -0,0 +1,118 @@
+package openhab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+// CreateItem adds a new item to the openHAB item registry and to the items cache.
+// Type, label, category, tags, group names, group type and group function are taken from the item definition.
+// It returns an ErrConflict error if an item with the same name already exists.
+func (c *Client) CreateItem(item api.Item) (*Item, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.CreateItemContext(ctx, item)
+}
+
+// CreateItemContext adds a new item to the openHAB item registry and to the items cache.
+// Type, label, category, tags, group names, group type and group function are taken from the item definition.
+// It returns an ErrConflict error if an item with the same name already exists.
+func (c *Client) CreateItemContext(ctx context.Context, item api.Item) (*Item, error) {
+	if item.Name == "" {
+		return nil, errors.New("cannot create item: missing item name")
+	}
+	_, err := c.items.getItem(ctx, item.Name)
+	if err == nil {
+		return nil, fmt.Errorf("cannot create item %q: %w", item.Name, ErrConflict)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("cannot create item %q: %w", item.Name, err)
+	}
+	return c.putItem(ctx, item)
+}
+
+// UpdateItem replaces the definition of an existing item in the openHAB item registry and in the items cache.
+// The state and the metadata of the item are not changed.
+// It returns an ErrNotFound error if the item does not exist.
+func (c *Client) UpdateItem(item api.Item) (*Item, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.UpdateItemContext(ctx, item)
+}
+
+// UpdateItemContext replaces the definition of an existing item in the openHAB item registry and in the items cache.
+// The state and the metadata of the item are not changed.
+// It returns an ErrNotFound error if the item does not exist.
+func (c *Client) UpdateItemContext(ctx context.Context, item api.Item) (*Item, error) {
+	if item.Name == "" {
+		return nil, errors.New("cannot update item: missing item name")
+	}
+	_, err := c.items.getItem(ctx, item.Name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update item %q: %w", item.Name, err)
+	}
+	return c.putItem(ctx, item)
+}
+
+// DeleteItem removes an item from the openHAB item registry and from the items cache.
+// It returns an ErrNotFound error if the item does not exist.
+func (c *Client) DeleteItem(itemName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.DeleteItemContext(ctx, itemName)
+}
+
+// DeleteItemContext removes an item from the openHAB item registry and from the items cache.
+// It returns an ErrNotFound error if the item does not exist.
+func (c *Client) DeleteItemContext(ctx context.Context, itemName string) error {
+	err := c.send(ctx, http.MethodDelete, itemsPath+url.PathEscape(itemName), "", http.NoBody, nil)
+	if err != nil {
+		return fmt.Errorf("cannot delete item %q: %w", itemName, err)
+	}
+	c.items.removeItem(itemName)
+	return nil
+}
+
+// CreateOrUpdateItems adds or updates a list of items in one call to the openHAB item registry.
+// It returns the status of each item: the items cache is updated with the items created or updated.
+func (c *Client) CreateOrUpdateItems(items []api.Item) ([]api.BulkItemResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.CreateOrUpdateItemsContext(ctx, items)
+}
+
+// CreateOrUpdateItemsContext adds or updates a list of items in one call to the openHAB item registry.
+// It returns the status of each item: the items cache is updated with the items created or updated.
+func (c *Client) CreateOrUpdateItemsContext(ctx context.Context, items []api.Item) ([]api.BulkItemResult, error) {
+	results := make([]api.BulkItemResult, 0, len(items))
+	err := c.putJSON(ctx, "items", items, &results)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create or update items: %w", err)
+	}
+	saved := make(map[string]bool, len(results))
+	for _, result := range results {
+		if result.Status == api.BulkItemCreated || result.Status == api.BulkItemUpdated {
+			saved[result.Name] = true
+		}
+	}
+	for _, item := range items {
+		if saved[item.Name] {
+			c.items.setItemDefinition(item)
+		}
+	}
+	return results, nil
+}
+
+// putItem adds or updates the item in the registry, then in the cache
+func (c *Client) putItem(ctx context.Context, item api.Item) (*Item, error) {
+	err := c.putJSON(ctx, itemsPath+url.PathEscape(item.Name), item, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot save item %q: %w", item.Name, err)
+	}
+	return c.items.setItemDefinition(item), nil
+}
@@ -0,0 +1,118 @@
+package openhab
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemRegistryAPI(t *testing.T) {
+	t.Parallel()
+	wg := sync.WaitGroup{}
+	server := openhabtest.NewServer(openhabtest.Config{
+		SendEventsFromAPI: true,
+		Log:               t,
+	})
+	require.NoError(t, server.SetItem(api.Item{Name: "Existing", Type: "Switch", State: "ON"}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		client.Start()
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	t.Run("TestCreateItem", func(t *testing.T) {
+		item, err := client.CreateItem(api.Item{
+			Name:       "Virtual_Heating",
+			Type:       "Number:Temperature",
+			Label:      "Heating demand",
+			Category:   "heating",
+			Tags:       []string{"Setpoint"},
+			GroupNames: []string{"Heating"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ItemTypeNumber, item.Type())
+		assert.True(t, item.IsMemberOf("Heating"))
+		assert.True(t, IsUndefined(item.getInternalState()))
+
+		// the item is in the cache
+		cached, err := client.GetItem("Virtual_Heating")
+		require.NoError(t, err)
+		assert.Same(t, item, cached)
+
+		_, err = client.CreateItem(api.Item{Name: "Existing", Type: "Switch"})
+		assert.ErrorIs(t, err, ErrConflict)
+
+		_, err = client.CreateItem(api.Item{Type: "Switch"})
+		assert.Error(t, err)
+	})
+
+	t.Run("TestCreateGroup", func(t *testing.T) {
+		item, err := client.CreateItem(api.Item{
+			Name:      "Heating",
+			Type:      "Group",
+			GroupType: "Number",
+			Function:  &api.Function{Name: "SUM"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ItemTypeGroup, item.Type())
+	})
+
+	t.Run("TestUpdateItem", func(t *testing.T) {
+		item, err := client.UpdateItem(api.Item{Name: "Existing", Type: "Switch", Label: "Existing switch", Tags: []string{"Switch"}})
+		require.NoError(t, err)
+		assert.Equal(t, "Existing switch", item.data.Label)
+		// the state is not changed
+		state, err := item.State()
+		require.NoError(t, err)
+		assert.Equal(t, SwitchON, state)
+
+		_, err = client.UpdateItem(api.Item{Name: "Unknown", Type: "Switch"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("TestCreateOrUpdateItems", func(t *testing.T) {
+		results, err := client.CreateOrUpdateItems([]api.Item{
+			{Name: "Existing", Type: "Switch", Label: "Updated"},
+			{Name: "Virtual_Lights", Type: "Switch"},
+			{Name: "Invalid"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []api.BulkItemResult{
+			{Name: "Existing", Status: api.BulkItemUpdated},
+			{Name: "Virtual_Lights", Status: api.BulkItemCreated},
+			{Name: "Invalid", Status: api.BulkItemError, Message: "invalid item definition"},
+		}, results)
+
+		existing, err := client.GetItem("Existing")
+		require.NoError(t, err)
+		assert.Equal(t, "Updated", existing.data.Label)
+
+		item, err := client.GetItem("Virtual_Lights")
+		require.NoError(t, err)
+		assert.Equal(t, ItemTypeSwitch, item.Type())
+	})
+
+	t.Run("TestDeleteItem", func(t *testing.T) {
+		require.NoError(t, client.DeleteItem("Virtual_Lights"))
+		assert.ErrorIs(t, client.DeleteItem("Virtual_Lights"), ErrNotFound)
+
+		_, err := client.GetItem("Virtual_Lights")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	client.Stop()
+	wg.Wait()
+	server.Close()
+
+	assert.NoError(t, server.EventsErr())
+	assert.NoError(t, server.ItemsErr())
+}
@@ -1,6 +1,8 @@
 package openhab
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
 	"math"
 	"strconv"
@@ -22,6 +24,14 @@ var (
 	_ State = StringState("")
 	_ State = DecimalState{}
 	_ State = DateTimeState{}
+	_ State = HSBState{}
+	_ State = PercentState(0)
+	_ State = OpenClosedState("")
+	_ State = UpDownState("")
+	_ State = RollershutterState(0)
+	_ State = PointState{}
+	_ State = RawState{}
+	_ State = UnDefState("")
 )
 
 type SwitchState string
@@ -44,11 +54,50 @@ func (s SwitchState) Equal(other string) bool {
 }
 
 const (
-	StateNULL = "NULL"
-	StateOFF  = "OFF"
-	StateON   = "ON"
+	StateNULL  = "NULL"
+	StateUNDEF = "UNDEF"
+	StateOFF   = "OFF"
+	StateON    = "ON"
 )
 
+// UnDefState is the state of an item which has no value:
+// NULL when the item has never been initialized, UNDEF when the value is unknown (like a binding being offline).
+// Any item type can be in an undefined state.
+type UnDefState string
+
+const (
+	UnDefNULL  UnDefState = StateNULL
+	UnDefUNDEF UnDefState = StateUNDEF
+)
+
+func (s UnDefState) String() string {
+	return string(s)
+}
+
+func (s UnDefState) Raw() interface{} {
+	return string(s)
+}
+
+func (s UnDefState) Equal(other string) bool {
+	return string(s) == other
+}
+
+// IsUndefined returns true if the state is nil, NULL or UNDEF
+func IsUndefined(state State) bool {
+	if state == nil {
+		return true
+	}
+	if _, ok := state.(UnDefState); ok {
+		return true
+	}
+	return isUndefinedValue(state.String())
+}
+
+// isUndefinedValue returns true if the raw state value is NULL or UNDEF
+func isUndefinedValue(value string) bool {
+	return value == StateNULL || value == StateUNDEF
+}
+
 type StringState string
 
 func (s StringState) String() string {
@@ -66,6 +115,8 @@ func (s StringState) Equal(other string) bool {
 type DecimalState struct {
 	value float64
 	unit  string
+	// dimension of the item when the unit is missing (like a unitless value of a "Number:Temperature" item)
+	dimension Dimension
 }
 
 // NewDecimalState creates a DecimalState with a unit
@@ -96,6 +147,100 @@ func (s DecimalState) Unit() string {
 	return s.unit
 }
 
+// Dimension returns the dimension of the unit. A state without unit (or with an unknown unit)
+// returns the dimension of its "Number:<dimension>" item, or DimensionNone.
+func (s DecimalState) Dimension() Dimension {
+	definition, err := getUnit(s.unit)
+	if err != nil || definition.dimension == DimensionNone {
+		return s.dimension
+	}
+	return definition.dimension
+}
+
+// withDimension returns the state with the dimension of its item, when the unit is not giving it already
+func (s DecimalState) withDimension(dimension Dimension) DecimalState {
+	if definition, err := getUnit(s.unit); err == nil && definition.dimension != DimensionNone {
+		return s
+	}
+	s.dimension = dimension
+	return s
+}
+
+// ConvertTo returns the value converted to another unit of the same dimension.
+// Unlike NewDecimalState, the converted value is not rounded.
+func (s DecimalState) ConvertTo(unit string) (DecimalState, error) {
+	value, err := convertUnit(s.value, s.unit, unit)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	return DecimalState{value: value, unit: unit}, nil
+}
+
+// Add returns the sum of both values, in the unit of s
+func (s DecimalState) Add(other DecimalState) (DecimalState, error) {
+	value, err := convertUnit(other.value, other.unit, s.unit)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	return DecimalState{value: s.value + value, unit: s.unit, dimension: s.dimension}, nil
+}
+
+// Subtract returns the difference of both values, in the unit of s
+func (s DecimalState) Subtract(other DecimalState) (DecimalState, error) {
+	value, err := convertUnit(other.value, other.unit, s.unit)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	return DecimalState{value: s.value - value, unit: s.unit, dimension: s.dimension}, nil
+}
+
+// Multiply returns the value multiplied by a factor, in the same unit
+func (s DecimalState) Multiply(factor float64) DecimalState {
+	return DecimalState{value: s.value * factor, unit: s.unit, dimension: s.dimension}
+}
+
+// Divide returns the value divided by a divisor, in the same unit
+func (s DecimalState) Divide(divisor float64) DecimalState {
+	return DecimalState{value: s.value / divisor, unit: s.unit, dimension: s.dimension}
+}
+
+// Compare returns -1, 0 or 1 if s is lower, equal or greater than other.
+// The other value is converted to the unit of s first: it returns an error if the units are not compatible.
+func (s DecimalState) Compare(other DecimalState) (int, error) {
+	value, err := convertUnit(other.value, other.unit, s.unit)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case s.value < value:
+		return -1, nil
+	case s.value > value:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// GreaterThan returns true if s is greater than other.
+// It returns an error if the units are not compatible.
+func (s DecimalState) GreaterThan(other DecimalState) (bool, error) {
+	result, err := s.Compare(other)
+	if err != nil {
+		return false, err
+	}
+	return result > 0, nil
+}
+
+// LessThan returns true if s is lower than other.
+// It returns an error if the units are not compatible.
+func (s DecimalState) LessThan(other DecimalState) (bool, error) {
+	result, err := s.Compare(other)
+	if err != nil {
+		return false, err
+	}
+	return result < 0, nil
+}
+
 func (s DecimalState) Equal(other string) bool {
 	compare, err := ParseDecimalState(other)
 	if err != nil {
@@ -170,3 +315,411 @@ func MustParseDateTimeState(value string) DateTimeState {
 	number, _ := ParseDateTimeState(value)
 	return number
 }
+
+// HSBState is the state of a Color item: hue (0-360), saturation (0-100) and brightness (0-100)
+type HSBState struct {
+	hue        float64
+	saturation float64
+	brightness float64
+}
+
+// NewHSBState creates a HSBState
+func NewHSBState(hue, saturation, brightness float64) HSBState {
+	return HSBState{
+		hue:        hue,
+		saturation: saturation,
+		brightness: brightness,
+	}
+}
+
+func (s HSBState) String() string {
+	return formatFloat(s.hue) + "," + formatFloat(s.saturation) + "," + formatFloat(s.brightness)
+}
+
+func (s HSBState) Raw() interface{} {
+	return []float64{s.hue, s.saturation, s.brightness}
+}
+
+func (s HSBState) Hue() float64 {
+	return s.hue
+}
+
+func (s HSBState) Saturation() float64 {
+	return s.saturation
+}
+
+func (s HSBState) Brightness() float64 {
+	return s.brightness
+}
+
+func (s HSBState) Equal(other string) bool {
+	compare, err := ParseHSBState(other)
+	if err != nil {
+		return false
+	}
+	return s == compare
+}
+
+// ParseHSBState converts a string like "120,80,50" to a HSBState
+func ParseHSBState(value string) (HSBState, error) {
+	values, err := parseFloats(value, 3, 3)
+	if err != nil {
+		return HSBState{}, fmt.Errorf("invalid HSB state %q: %w", value, err)
+	}
+	if values[0] < 0 || values[0] > 360 {
+		return HSBState{}, fmt.Errorf("invalid HSB state %q: hue out of range", value)
+	}
+	if !isPercent(values[1]) || !isPercent(values[2]) {
+		return HSBState{}, fmt.Errorf("invalid HSB state %q: saturation or brightness out of range", value)
+	}
+	return NewHSBState(values[0], values[1], values[2]), nil
+}
+
+// MustParseHSBState does not panic if the string is not a valid HSB value, it returns 0,0,0 instead
+func MustParseHSBState(value string) HSBState {
+	state, _ := ParseHSBState(value)
+	return state
+}
+
+// PercentState is the state of a Dimmer item: a value between 0 and 100
+type PercentState float64
+
+// NewPercentState creates a PercentState
+func NewPercentState(value float64) PercentState {
+	return PercentState(value)
+}
+
+func (s PercentState) String() string {
+	return formatFloat(float64(s))
+}
+
+func (s PercentState) Raw() interface{} {
+	return float64(s)
+}
+
+func (s PercentState) Float64() float64 {
+	return float64(s)
+}
+
+func (s PercentState) Equal(other string) bool {
+	compare, err := ParsePercentState(other)
+	if err != nil {
+		return false
+	}
+	return s == compare
+}
+
+// ParsePercentState converts a string to a PercentState
+func ParsePercentState(value string) (PercentState, error) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, err
+	}
+	if !isPercent(number) {
+		return 0, fmt.Errorf("invalid percent state %q: out of range", value)
+	}
+	return PercentState(number), nil
+}
+
+// MustParsePercentState does not panic if the string is not a valid percentage, it returns 0 instead
+func MustParsePercentState(value string) PercentState {
+	state, _ := ParsePercentState(value)
+	return state
+}
+
+// OpenClosedState is the state of a Contact item
+type OpenClosedState string
+
+const (
+	ContactOPEN   OpenClosedState = "OPEN"
+	ContactCLOSED OpenClosedState = "CLOSED"
+)
+
+func (s OpenClosedState) String() string {
+	return strings.ToUpper(string(s))
+}
+
+func (s OpenClosedState) Raw() interface{} {
+	return string(s)
+}
+
+func (s OpenClosedState) Equal(other string) bool {
+	return s.String() == other
+}
+
+// ParseOpenClosedState converts "OPEN" or "CLOSED" to an OpenClosedState
+func ParseOpenClosedState(value string) (OpenClosedState, error) {
+	state := OpenClosedState(strings.ToUpper(strings.TrimSpace(value)))
+	if state != ContactOPEN && state != ContactCLOSED {
+		return "", fmt.Errorf("invalid open/closed state %q", value)
+	}
+	return state, nil
+}
+
+// MustParseOpenClosedState does not panic if the string is not a valid value, it returns an empty state instead
+func MustParseOpenClosedState(value string) OpenClosedState {
+	state, _ := ParseOpenClosedState(value)
+	return state
+}
+
+// UpDownState is an UP or DOWN value, which can be used to update a Rollershutter item
+type UpDownState string
+
+const (
+	RollershutterUP   UpDownState = "UP"
+	RollershutterDOWN UpDownState = "DOWN"
+)
+
+func (s UpDownState) String() string {
+	return strings.ToUpper(string(s))
+}
+
+func (s UpDownState) Raw() interface{} {
+	return string(s)
+}
+
+func (s UpDownState) Equal(other string) bool {
+	return s.String() == other
+}
+
+// ParseUpDownState converts "UP" or "DOWN" to an UpDownState
+func ParseUpDownState(value string) (UpDownState, error) {
+	state := UpDownState(strings.ToUpper(strings.TrimSpace(value)))
+	if state != RollershutterUP && state != RollershutterDOWN {
+		return "", fmt.Errorf("invalid up/down state %q", value)
+	}
+	return state, nil
+}
+
+// MustParseUpDownState does not panic if the string is not a valid value, it returns an empty state instead
+func MustParseUpDownState(value string) UpDownState {
+	state, _ := ParseUpDownState(value)
+	return state
+}
+
+// RollershutterState is the state of a Rollershutter item: a position between 0 (UP) and 100 (DOWN)
+type RollershutterState float64
+
+// NewRollershutterState creates a RollershutterState
+func NewRollershutterState(value float64) RollershutterState {
+	return RollershutterState(value)
+}
+
+func (s RollershutterState) String() string {
+	return formatFloat(float64(s))
+}
+
+func (s RollershutterState) Raw() interface{} {
+	return float64(s)
+}
+
+func (s RollershutterState) Float64() float64 {
+	return float64(s)
+}
+
+// IsUp returns true if the rollershutter is fully opened
+func (s RollershutterState) IsUp() bool {
+	return s == 0
+}
+
+// IsDown returns true if the rollershutter is fully closed
+func (s RollershutterState) IsDown() bool {
+	return s == 100
+}
+
+// Equal compares the position with a percentage, or with UP (0) or DOWN (100)
+func (s RollershutterState) Equal(other string) bool {
+	compare, err := ParseRollershutterState(other)
+	if err != nil {
+		return false
+	}
+	return s == compare
+}
+
+// ParseRollershutterState converts a percentage, UP or DOWN to a RollershutterState
+func ParseRollershutterState(value string) (RollershutterState, error) {
+	if upDown, err := ParseUpDownState(value); err == nil {
+		if upDown == RollershutterUP {
+			return 0, nil
+		}
+		return 100, nil
+	}
+	percent, err := ParsePercentState(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rollershutter state %q: %w", value, err)
+	}
+	return RollershutterState(percent), nil
+}
+
+// MustParseRollershutterState does not panic if the string is not a valid value, it returns 0 instead
+func MustParseRollershutterState(value string) RollershutterState {
+	state, _ := ParseRollershutterState(value)
+	return state
+}
+
+// PointState is the state of a Location item: latitude, longitude and an optional altitude
+type PointState struct {
+	latitude    float64
+	longitude   float64
+	altitude    float64
+	hasAltitude bool
+}
+
+// NewPointState creates a PointState without altitude
+func NewPointState(latitude, longitude float64) PointState {
+	return PointState{
+		latitude:  latitude,
+		longitude: longitude,
+	}
+}
+
+// NewPointStateWithAltitude creates a PointState with an altitude
+func NewPointStateWithAltitude(latitude, longitude, altitude float64) PointState {
+	return PointState{
+		latitude:    latitude,
+		longitude:   longitude,
+		altitude:    altitude,
+		hasAltitude: true,
+	}
+}
+
+func (s PointState) String() string {
+	value := formatFloat(s.latitude) + "," + formatFloat(s.longitude)
+	if s.hasAltitude {
+		value += "," + formatFloat(s.altitude)
+	}
+	return value
+}
+
+func (s PointState) Raw() interface{} {
+	if s.hasAltitude {
+		return []float64{s.latitude, s.longitude, s.altitude}
+	}
+	return []float64{s.latitude, s.longitude}
+}
+
+func (s PointState) Latitude() float64 {
+	return s.latitude
+}
+
+func (s PointState) Longitude() float64 {
+	return s.longitude
+}
+
+// Altitude returns the altitude, and false if the location has no altitude
+func (s PointState) Altitude() (float64, bool) {
+	return s.altitude, s.hasAltitude
+}
+
+func (s PointState) Equal(other string) bool {
+	compare, err := ParsePointState(other)
+	if err != nil {
+		return false
+	}
+	return s == compare
+}
+
+// ParsePointState converts a string like "52.5200066,13.4049540[,34.0]" to a PointState
+func ParsePointState(value string) (PointState, error) {
+	values, err := parseFloats(value, 2, 3)
+	if err != nil {
+		return PointState{}, fmt.Errorf("invalid point state %q: %w", value, err)
+	}
+	if values[0] < -90 || values[0] > 90 || values[1] < -180 || values[1] > 180 {
+		return PointState{}, fmt.Errorf("invalid point state %q: coordinates out of range", value)
+	}
+	if len(values) == 3 {
+		return NewPointStateWithAltitude(values[0], values[1], values[2]), nil
+	}
+	return NewPointState(values[0], values[1]), nil
+}
+
+// MustParsePointState does not panic if the string is not a valid location, it returns 0,0 instead
+func MustParsePointState(value string) PointState {
+	state, _ := ParsePointState(value)
+	return state
+}
+
+// RawState is the state of an Image item: binary data with a mime type.
+// Its string representation is a data URL like "data:image/png;base64,iVBORw0KGgo..."
+type RawState struct {
+	mimeType string
+	data     []byte
+}
+
+// NewRawState creates a RawState
+func NewRawState(mimeType string, data []byte) RawState {
+	return RawState{
+		mimeType: mimeType,
+		data:     data,
+	}
+}
+
+func (s RawState) String() string {
+	return "data:" + s.mimeType + ";base64," + base64.StdEncoding.EncodeToString(s.data)
+}
+
+func (s RawState) Raw() interface{} {
+	return s.data
+}
+
+func (s RawState) MimeType() string {
+	return s.mimeType
+}
+
+func (s RawState) Bytes() []byte {
+	return s.data
+}
+
+func (s RawState) Equal(other string) bool {
+	compare, err := ParseRawState(other)
+	if err != nil {
+		return false
+	}
+	return s.mimeType == compare.mimeType && bytes.Equal(s.data, compare.data)
+}
+
+// ParseRawState converts a base64 data URL to a RawState
+func ParseRawState(value string) (RawState, error) {
+	header, encoded, found := strings.Cut(value, ",")
+	if !found || !strings.HasPrefix(header, "data:") || !strings.HasSuffix(header, ";base64") {
+		return RawState{}, fmt.Errorf("invalid raw state: expected a base64 data URL")
+	}
+	mimeType := strings.TrimSuffix(strings.TrimPrefix(header, "data:"), ";base64")
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return RawState{}, fmt.Errorf("invalid raw state: %w", err)
+	}
+	return NewRawState(mimeType, data), nil
+}
+
+// MustParseRawState does not panic if the string is not a valid data URL, it returns an empty state instead
+func MustParseRawState(value string) RawState {
+	state, _ := ParseRawState(value)
+	return state
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func isPercent(value float64) bool {
+	return value >= 0 && value <= 100
+}
+
+// parseFloats parses a list of comma separated numbers
+func parseFloats(value string, minCount, maxCount int) ([]float64, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) < minCount || len(parts) > maxCount {
+		return nil, fmt.Errorf("expected %d to %d values but found %d", minCount, maxCount, len(parts))
+	}
+	values := make([]float64, len(parts))
+	for i, part := range parts {
+		number, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = number
+	}
+	return values, nil
+}
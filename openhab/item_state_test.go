@@ -35,6 +35,16 @@ func TestItemState(t *testing.T) {
 		{StringState("test"), "test", "test", "other"},
 		{NewDecimalState(2.3, "cm"), float64(2.3), "2.3 cm", "2.3"},
 		{NewDateTimeState(dateTime), dateTime, "2022-03-08T07:01:00+0000", "2022-03-08T07:01:01+0000"},
+		{NewHSBState(120, 80, 50.5), []float64{120, 80, 50.5}, "120,80,50.5", "120,80,50"},
+		{NewPercentState(42), float64(42), "42", "43"},
+		{ContactOPEN, "OPEN", "OPEN", "CLOSED"},
+		{RollershutterDOWN, "DOWN", "DOWN", "UP"},
+		{NewRollershutterState(25), float64(25), "25", "UP"},
+		{NewPointState(52.5200066, 13.404954), []float64{52.5200066, 13.404954}, "52.5200066,13.404954", "52.5200066,13.404954,10"},
+		{NewPointStateWithAltitude(52.52, 13.4, 34), []float64{52.52, 13.4, 34}, "52.52,13.4,34", "52.52,13.4"},
+		{NewRawState("image/png", []byte{1, 2, 3}), []byte{1, 2, 3}, "data:image/png;base64,AQID", "data:image/jpeg;base64,AQID"},
+		{UnDefNULL, "NULL", "NULL", "UNDEF"},
+		{UnDefUNDEF, "UNDEF", "UNDEF", "NULL"},
 	}
 
 	for _, fixture := range fixtures {
@@ -47,3 +57,83 @@ func TestItemState(t *testing.T) {
 		})
 	}
 }
+
+func TestParseStates(t *testing.T) {
+	t.Parallel()
+	fixtures := []struct {
+		value string
+		parse func(string) (State, error)
+		valid bool
+	}{
+		{"120,80,50", func(s string) (State, error) { return ParseHSBState(s) }, true},
+		{" 360, 100, 0", func(s string) (State, error) { return ParseHSBState(s) }, true},
+		{"361,80,50", func(s string) (State, error) { return ParseHSBState(s) }, false},
+		{"120,101,50", func(s string) (State, error) { return ParseHSBState(s) }, false},
+		{"120,80", func(s string) (State, error) { return ParseHSBState(s) }, false},
+		{"ON", func(s string) (State, error) { return ParseHSBState(s) }, false},
+		{"0", func(s string) (State, error) { return ParsePercentState(s) }, true},
+		{"99.5", func(s string) (State, error) { return ParsePercentState(s) }, true},
+		{"101", func(s string) (State, error) { return ParsePercentState(s) }, false},
+		{"-1", func(s string) (State, error) { return ParsePercentState(s) }, false},
+		{"OPEN", func(s string) (State, error) { return ParseOpenClosedState(s) }, true},
+		{"closed", func(s string) (State, error) { return ParseOpenClosedState(s) }, true},
+		{"ON", func(s string) (State, error) { return ParseOpenClosedState(s) }, false},
+		{"UP", func(s string) (State, error) { return ParseUpDownState(s) }, true},
+		{"STOP", func(s string) (State, error) { return ParseUpDownState(s) }, false},
+		{"DOWN", func(s string) (State, error) { return ParseRollershutterState(s) }, true},
+		{"30", func(s string) (State, error) { return ParseRollershutterState(s) }, true},
+		{"STOP", func(s string) (State, error) { return ParseRollershutterState(s) }, false},
+		{"52.52,13.4", func(s string) (State, error) { return ParsePointState(s) }, true},
+		{"52.52,13.4,34.5", func(s string) (State, error) { return ParsePointState(s) }, true},
+		{"91,13.4", func(s string) (State, error) { return ParsePointState(s) }, false},
+		{"52.52", func(s string) (State, error) { return ParsePointState(s) }, false},
+		{"data:image/png;base64,AQID", func(s string) (State, error) { return ParseRawState(s) }, true},
+		{"data:image/png,AQID", func(s string) (State, error) { return ParseRawState(s) }, false},
+		{"data:image/png;base64,@@@", func(s string) (State, error) { return ParseRawState(s) }, false},
+		{"AQID", func(s string) (State, error) { return ParseRawState(s) }, false},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.value, func(t *testing.T) {
+			t.Parallel()
+			state, err := fixture.parse(fixture.value)
+			if !fixture.valid {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, state.Equal(state.String()))
+		})
+	}
+}
+
+func TestRollershutterState(t *testing.T) {
+	t.Parallel()
+	assert.True(t, MustParseRollershutterState("UP").IsUp())
+	assert.True(t, MustParseRollershutterState("100").IsDown())
+	assert.False(t, MustParseRollershutterState("50").IsDown())
+	assert.True(t, NewRollershutterState(0).Equal("UP"))
+	assert.True(t, NewRollershutterState(100).Equal("DOWN"))
+}
+
+func TestMustParseInvalidStates(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, HSBState{}, MustParseHSBState("invalid"))
+	assert.Equal(t, PercentState(0), MustParsePercentState("invalid"))
+	assert.Equal(t, OpenClosedState(""), MustParseOpenClosedState("invalid"))
+	assert.Equal(t, UpDownState(""), MustParseUpDownState("invalid"))
+	assert.Equal(t, RollershutterState(0), MustParseRollershutterState("invalid"))
+	assert.Equal(t, PointState{}, MustParsePointState("invalid"))
+	assert.Equal(t, RawState{}, MustParseRawState("invalid"))
+}
+
+func TestIsUndefined(t *testing.T) {
+	t.Parallel()
+	assert.True(t, IsUndefined(nil))
+	assert.True(t, IsUndefined(UnDefNULL))
+	assert.True(t, IsUndefined(UnDefUNDEF))
+	assert.True(t, IsUndefined(StringState("UNDEF")))
+	assert.False(t, IsUndefined(SwitchON))
+	assert.False(t, IsUndefined(NewDecimalState(0, "")))
+	assert.False(t, IsUndefined(StringState("")))
+}
@@ -2,6 +2,7 @@ package openhab
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"sync"
 	"testing"
@@ -17,17 +18,130 @@ import (
 func TestItemDecimalType(t *testing.T) {
 	item := newTestItem(nil, "temperature", "Number", "20.2")
 	assert.Equal(t, ItemType("Number"), item.Type())
-	assert.Equal(t, DecimalState{20.2, ""}, item.state)
+	assert.Equal(t, DecimalState{value: 20.2}, item.state)
 	assert.Equal(t, "20.2", item.state.String())
 }
 
 func TestItemDecimalTypeWithUnit(t *testing.T) {
 	item := newTestItem(nil, "temperature", "Number:Temperature", "20.2 °C")
 	assert.Equal(t, ItemType("Number"), item.Type())
-	assert.Equal(t, DecimalState{20.2, "°C"}, item.state)
+	assert.Equal(t, DecimalState{value: 20.2, unit: "°C"}, item.state)
 	assert.Equal(t, "20.2 °C", item.state.String())
 }
 
+func TestItemStateFromType(t *testing.T) {
+	t.Parallel()
+	fixtures := []struct {
+		itemType string
+		state    string
+		expected State
+	}{
+		{"Switch", "ON", SwitchON},
+		{"String", "hello", StringState("hello")},
+		{"Color", "120,80,50", NewHSBState(120, 80, 50)},
+		{"Dimmer", "75", NewPercentState(75)},
+		{"Contact", "CLOSED", ContactCLOSED},
+		{"Rollershutter", "40", NewRollershutterState(40)},
+		{"Location", "52.52,13.4", NewPointState(52.52, 13.4)},
+		{"Image", "data:image/png;base64,AQID", NewRawState("image/png", []byte{1, 2, 3})},
+		{"Number", "NULL", UnDefNULL},
+		{"Number:Temperature", "UNDEF", UnDefUNDEF},
+		{"DateTime", "NULL", UnDefNULL},
+		{"Color", "UNDEF", UnDefUNDEF},
+		{"String", "NULL", UnDefNULL},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.itemType+" "+fixture.state, func(t *testing.T) {
+			t.Parallel()
+			item := newTestItem(nil, "item", fixture.itemType, fixture.state)
+			assert.Equal(t, fixture.expected, item.state)
+			assert.Equal(t, fixture.state, item.state.String())
+		})
+	}
+}
+
+func TestItemAccessors(t *testing.T) {
+	t.Parallel()
+	payload := `{
+		"link": "http://localhost:8080/rest/items/Temperature_Kitchen",
+		"state": "21.5 °C",
+		"transformedState": "21.5 °C",
+		"stateDescription": {
+			"minimum": 5.5,
+			"maximum": 30,
+			"step": 0.5,
+			"pattern": "%.1f %unit%",
+			"readOnly": false,
+			"options": [{"value": "21", "label": "Comfort"}]
+		},
+		"commandDescription": {"commandOptions": [{"command": "21", "label": "Comfort"}]},
+		"editable": true,
+		"type": "Number:Temperature",
+		"name": "Temperature_Kitchen",
+		"label": "Kitchen temperature",
+		"category": "temperature",
+		"tags": ["Setpoint", "Temperature"],
+		"groupNames": ["Kitchen", "Temperatures"]
+	}`
+	data := api.Item{}
+	require.NoError(t, json.Unmarshal([]byte(payload), &data))
+	item := newItem(nil, data.Name).set(data)
+
+	assert.Equal(t, "Kitchen temperature", item.Label())
+	assert.Equal(t, "temperature", item.Category())
+	assert.Equal(t, []string{"Setpoint", "Temperature"}, item.Tags())
+	assert.True(t, item.HasTag("setpoint"))
+	assert.False(t, item.HasTag("Lighting"))
+	assert.Equal(t, []string{"Kitchen", "Temperatures"}, item.GroupNames())
+	assert.Equal(t, "Temperature", item.SubType())
+	assert.Equal(t, DimensionTemperature, item.Dimension())
+	assert.Equal(t, "21.5 °C", item.TransformedState())
+	assert.Equal(t, ItemType(""), item.GroupType())
+
+	_, ok := item.GroupFunction()
+	assert.False(t, ok)
+
+	stateDescription, ok := item.StateDescription()
+	require.True(t, ok)
+	assert.Equal(t, 5.5, stateDescription.Minimum)
+	assert.Equal(t, 30.0, stateDescription.Maximum)
+	assert.Equal(t, 0.5, stateDescription.Step)
+	assert.Equal(t, "%.1f %unit%", stateDescription.Pattern)
+	assert.Equal(t, []api.StateOptions{{Value: "21", Label: "Comfort"}}, stateDescription.Options)
+
+	commandDescription, ok := item.CommandDescription()
+	require.True(t, ok)
+	assert.Equal(t, []api.CommandOptions{{Command: "21", Label: "Comfort"}}, commandDescription.Options)
+
+	// the copies can be modified safely
+	tags := item.Tags()
+	tags[0] = "Changed"
+	assert.Equal(t, []string{"Setpoint", "Temperature"}, item.Tags())
+}
+
+func TestGroupItemAccessors(t *testing.T) {
+	t.Parallel()
+	item := newItem(nil, "Lights").set(api.Item{
+		Name:      "Lights",
+		Type:      "Group",
+		GroupType: "Switch",
+		Function:  &api.Function{Name: "OR", Params: []string{"ON", "OFF"}},
+	})
+	assert.Equal(t, ItemTypeSwitch, item.GroupType())
+	function, ok := item.GroupFunction()
+	require.True(t, ok)
+	assert.Equal(t, "OR", function.Name)
+	assert.Equal(t, []string{"ON", "OFF"}, function.Params)
+
+	_, ok = item.StateDescription()
+	assert.False(t, ok)
+	_, ok = item.CommandDescription()
+	assert.False(t, ok)
+	assert.Empty(t, item.SubType())
+	assert.Nil(t, item.Tags())
+}
+
 func TestGetItemAPI(t *testing.T) {
 	// don't run parallel (sub-tests are in order)
 	item1 := api.Item{
@@ -123,10 +237,9 @@ func TestGetItemAPI(t *testing.T) {
 		wg := sync.WaitGroup{}
 
 		for i := 0; i < 2; i++ {
-			wg.Add(1)
+			wg.Add(2)
 			go func(i int) {
 				// the event bus is not connected so we send an event manually
-				wg.Add(1) //nolint:staticcheck
 				go func(i int) {
 					time.Sleep(time.Duration(i+1) * time.Millisecond)
 					ev := event.NewItemReceivedState("TestSwitch", "OnOff", SwitchON.String())
@@ -158,6 +271,21 @@ func TestGetItemAPI(t *testing.T) {
 		assert.GreaterOrEqual(t, time.Since(start), timeout, "it should take 100ms or more to run the test")
 	})
 
+	t.Run("TestSendCommandWaitWithoutState", func(t *testing.T) {
+		item := newTestItem(client, "TestSwitch", "Switch", "OFF")
+
+		go func() {
+			// the event bus is not connected so we send an event manually
+			time.Sleep(10 * time.Millisecond)
+			ev := event.NewItemReceivedState("TestSwitch", "OnOff", SwitchOFF.String())
+			item.client.userEventBus.Publish(ev)
+		}()
+		// REFRESH has no equivalent state: any state received acknowledges it
+		ok, err := item.SendCommandWait(CommandREFRESH, 100*time.Millisecond)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
 	t.Run("TestMultipleSendCommandWait", func(t *testing.T) {
 		count := 10
 		initialState := 20.2
@@ -206,6 +334,16 @@ func newTestItem(client *Client, name, itemType, state string) *Item {
 	return item
 }
 
+// newTestServer starts a mock openHAB server loaded with the items
+func newTestServer(t *testing.T, items ...api.Item) *openhabtest.Server {
+	t.Helper()
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	for _, item := range items {
+		require.NoError(t, server.SetItem(item))
+	}
+	return server
+}
+
 // Unused for now
 // func newTestGroupItem(client *Client, name, groupItemType, state string) *Item {
 // 	item := newItem(client, name)
@@ -213,3 +351,53 @@ func newTestItem(client *Client, name, itemType, state string) *Item {
 // 	item.set(api.Item{Type: groupItemType, State: state})
 // 	return item
 // }
+
+func TestPostUpdate(t *testing.T) {
+	t.Parallel()
+	wg := sync.WaitGroup{}
+	server := openhabtest.NewServer(openhabtest.Config{
+		SendEventsFromAPI: true,
+		Log:               t,
+	})
+	require.NoError(t, server.SetItem(api.Item{Name: "VirtualTemperature", Type: "Number:Temperature", State: "19 °C"}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		client.Start()
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	ok, err := client.PostUpdateWait("VirtualTemperature", MustParseDecimalState("21.5 °C"), time.Second)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	item, err := client.GetItem("VirtualTemperature")
+	require.NoError(t, err)
+	ok, err = item.PostUpdateWait(UnDefUNDEF, time.Second)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	err = client.PostUpdate("VirtualTemperature", MustParseDecimalState("20 °C"))
+	require.NoError(t, err)
+
+	// bypass the cache to check the state on the server
+	state, err := client.getString(context.Background(), itemsPath+"VirtualTemperature/state")
+	require.NoError(t, err)
+	assert.Equal(t, "20 °C", state)
+
+	err = client.PostUpdate("UnknownItem", SwitchON)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	err = item.PostUpdate(nil)
+	assert.Error(t, err)
+
+	client.Stop()
+	wg.Wait()
+	server.Close()
+
+	assert.NoError(t, server.EventsErr())
+	assert.NoError(t, server.ItemsErr())
+}
@@ -20,6 +20,7 @@ const (
 	ItemTypeImage         ItemType = "Image"
 	ItemTypeLocation      ItemType = "Location"
 	ItemTypeNumber        ItemType = "Number"
+	ItemTypePlayer        ItemType = "Player"
 	ItemTypeRollershutter ItemType = "Rollershutter"
 	ItemTypeString        ItemType = "String"
 	ItemTypeSwitch        ItemType = "Switch"
@@ -51,6 +52,8 @@ func getItemType(s string) (ItemType, string) {
 		return ItemTypeLocation, ""
 	case string(ItemTypeNumber):
 		return ItemTypeNumber, ""
+	case string(ItemTypePlayer):
+		return ItemTypePlayer, ""
 	case string(ItemTypeRollershutter):
 		return ItemTypeRollershutter, ""
 	case string(ItemTypeString):
@@ -0,0 +1,93 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+const (
+	linksPath = "links/"
+)
+
+// GetLinks returns the links between items and channels.
+// The list can be filtered by item name and/or channel UID: pass an empty string to ignore a filter.
+func (c *Client) GetLinks(itemName, channelUID string) ([]api.ItemChannelLink, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetLinksContext(ctx, itemName, channelUID)
+}
+
+// GetLinksContext returns the links between items and channels.
+// The list can be filtered by item name and/or channel UID: pass an empty string to ignore a filter.
+func (c *Client) GetLinksContext(ctx context.Context, itemName, channelUID string) ([]api.ItemChannelLink, error) {
+	query := url.Values{}
+	if itemName != "" {
+		query.Set("itemName", itemName)
+	}
+	if channelUID != "" {
+		query.Set("channelUID", channelUID)
+	}
+	path := "links"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	links := make([]api.ItemChannelLink, 0)
+	err := c.getJSON(ctx, path, &links)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load links: %w", err)
+	}
+	return links, nil
+}
+
+// CreateLink links an item to a channel, or updates the configuration of an existing link.
+// The profile is set in the configuration with the key api.LinkConfigurationProfile.
+//
+// It returns ErrConflict if the link is not editable (like a link defined in a file).
+func (c *Client) CreateLink(link api.ItemChannelLink) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.CreateLinkContext(ctx, link)
+}
+
+// CreateLinkContext links an item to a channel, or updates the configuration of an existing link.
+// The profile is set in the configuration with the key api.LinkConfigurationProfile.
+//
+// It returns ErrConflict if the link is not editable (like a link defined in a file).
+func (c *Client) CreateLinkContext(ctx context.Context, link api.ItemChannelLink) error {
+	if link.Configuration == nil {
+		link.Configuration = map[string]any{}
+	}
+	err := c.putJSON(ctx, linkPath(link.ItemName, link.ChannelUID), link, nil)
+	if err != nil {
+		return fmt.Errorf("cannot link item %q to channel %q: %w", link.ItemName, link.ChannelUID, err)
+	}
+	return nil
+}
+
+// DeleteLink removes the link between an item and a channel.
+//
+// It returns ErrNotFound if the link doesn't exist, or ErrConflict if the link is not editable.
+func (c *Client) DeleteLink(itemName, channelUID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.DeleteLinkContext(ctx, itemName, channelUID)
+}
+
+// DeleteLinkContext removes the link between an item and a channel.
+//
+// It returns ErrNotFound if the link doesn't exist, or ErrConflict if the link is not editable.
+func (c *Client) DeleteLinkContext(ctx context.Context, itemName, channelUID string) error {
+	err := c.send(ctx, http.MethodDelete, linkPath(itemName, channelUID), "", http.NoBody, nil)
+	if err != nil {
+		return fmt.Errorf("cannot unlink item %q from channel %q: %w", itemName, channelUID, err)
+	}
+	return nil
+}
+
+func linkPath(itemName, channelUID string) string {
+	return linksPath + url.PathEscape(itemName) + "/" + url.PathEscape(channelUID)
+}
@@ -0,0 +1,113 @@
+package openhab
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinksAPI(t *testing.T) {
+	t.Parallel()
+	const (
+		itemName   = "Presence_Mobile"
+		channelUID = "network:pingdevice:3aadd7c9:online"
+		otherItem  = "Lamp_Color"
+		otherUID   = "hue:0220:001788a1b2c3:5:color"
+	)
+
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetLink(api.ItemChannelLink{ItemName: otherItem, ChannelUID: otherUID}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	t.Run("TestCreateLink", func(t *testing.T) {
+		err := client.CreateLink(api.ItemChannelLink{
+			ItemName:      itemName,
+			ChannelUID:    channelUID,
+			Configuration: map[string]any{api.LinkConfigurationProfile: "system:follow"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("TestGetLinks", func(t *testing.T) {
+		links, err := client.GetLinks("", "")
+		require.NoError(t, err)
+		assert.Len(t, links, 2)
+
+		links, err = client.GetLinks(itemName, "")
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, channelUID, links[0].ChannelUID)
+		assert.Equal(t, "system:follow", links[0].Configuration[api.LinkConfigurationProfile])
+
+		links, err = client.GetLinks("", otherUID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, otherItem, links[0].ItemName)
+	})
+
+	t.Run("TestDeleteLink", func(t *testing.T) {
+		require.NoError(t, client.DeleteLink(itemName, channelUID))
+		assert.ErrorIs(t, client.DeleteLink(itemName, channelUID), ErrNotFound)
+
+		links, err := client.GetLinks(itemName, "")
+		require.NoError(t, err)
+		assert.Empty(t, links)
+	})
+
+	assert.NoError(t, server.LinksErr())
+}
+
+func TestLinkEventsFromAPI(t *testing.T) {
+	t.Parallel()
+	const (
+		itemName   = "Presence_Mobile"
+		channelUID = "network:pingdevice:3aadd7c9:online"
+	)
+
+	server := openhabtest.NewServer(openhabtest.Config{Log: t, SendEventsFromAPI: true})
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	client.AddRule(RuleData{Name: "link added"}, func(ctx context.Context, client *Client, ruleData RuleData, e event.Event) {
+		defer wg.Done()
+		ev, ok := e.(event.ItemChannelLinkAdded)
+		assert.True(t, ok)
+		assert.Equal(t, "system:default", ev.Link.Configuration[api.LinkConfigurationProfile])
+	}, OnItemChannelLinkAdded(itemName, ""))
+	client.AddRule(RuleData{Name: "link removed"}, func(ctx context.Context, client *Client, ruleData RuleData, e event.Event) {
+		defer wg.Done()
+		_, ok := e.(event.ItemChannelLinkRemoved)
+		assert.True(t, ok)
+	}, OnItemChannelLinkRemoved("", channelUID))
+
+	go func() {
+		client.Start()
+	}()
+	defer client.Stop()
+
+	// wait until the client is subscribed to the event bus
+	time.Sleep(10 * time.Millisecond)
+
+	require.NoError(t, client.CreateLink(api.ItemChannelLink{
+		ItemName:      itemName,
+		ChannelUID:    channelUID,
+		Configuration: map[string]any{api.LinkConfigurationProfile: "system:default"},
+	}))
+	require.NoError(t, client.DeleteLink(itemName, channelUID))
+
+	wg.Wait()
+	assert.NoError(t, server.LinksErr())
+}
@@ -33,6 +33,24 @@ func (_m *mockSubscriber) getCron() *cron.Cron {
 	return r0
 }
 
+// isMemberOf provides a mock function with given fields: itemName, groupName
+func (_m *mockSubscriber) isMemberOf(itemName string, groupName string) bool {
+	ret := _m.Called(itemName, groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for isMemberOf")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(itemName, groupName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // subscribe provides a mock function with given fields: name, eventType, callback
 func (_m *mockSubscriber) subscribe(name string, eventType event.Type, callback func(event.Event)) int {
 	ret := _m.Called(name, eventType, callback)
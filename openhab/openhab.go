@@ -2,6 +2,7 @@ package openhab
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -44,6 +45,7 @@ type Client struct {
 	password       string
 	cron           *cron.Cron
 	items          *itemCollection
+	things         *thingCollection
 	rules          []*rule
 	rulesMutex     sync.Mutex
 	systemEventBus event.PubSub
@@ -128,11 +130,12 @@ func NewClient(config Config) *Client {
 		telemetry:      telemetry,
 	}
 	client.items = newItems(client)
+	client.things = newThings(client)
 	return client
 }
 
-// RefreshCache will force a reload of all the items from openHAB.
-// You shouldn't need to call this method, as the items are loaded on demand.
+// RefreshCache will force a reload of all the items and things from openHAB.
+// You shouldn't need to call this method, as the items and things are loaded on demand.
 //
 // I've only experienced the need to call this method when the openHAB server was restarted,
 // and gopenhab loaded the cache before openHAB finished its initialization.
@@ -143,14 +146,18 @@ func (c *Client) RefreshCache() error {
 	return c.RefreshCacheContext(ctx)
 }
 
-// RefreshCacheContext will force a reload of all the items from openHAB.
-// You shouldn't need to call this method, as the items are loaded on demand.
+// RefreshCacheContext will force a reload of all the items and things from openHAB.
+// You shouldn't need to call this method, as the items and things are loaded on demand.
 //
 // I've only experienced the need to call this method when the openHAB server was restarted,
 // and gopenhab loaded the cache before openHAB finished its initialization.
 // For that matter you can call RefreshCacheContext() on a OnStableConnection() event rule.
 func (c *Client) RefreshCacheContext(ctx context.Context) error {
-	return c.items.refreshCache(ctx)
+	err := c.items.refreshCache(ctx)
+	if err != nil {
+		return err
+	}
+	return c.things.refreshCache(ctx)
 }
 
 // GetItem returns an openHAB item from its name.
@@ -169,6 +176,20 @@ func (c *Client) GetItemContext(ctx context.Context, name string) (*Item, error)
 	return c.items.getItem(ctx, name)
 }
 
+// GetItems returns all the openHAB items, sorted by name.
+// If some metadata namespaces are given, only the items having metadata in all these namespaces are returned.
+func (c *Client) GetItems(metadataNamespaces ...string) ([]*Item, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetItemsContext(ctx, metadataNamespaces...)
+}
+
+// GetItemsContext returns all the openHAB items, sorted by name.
+// If some metadata namespaces are given, only the items having metadata in all these namespaces are returned.
+func (c *Client) GetItemsContext(ctx context.Context, metadataNamespaces ...string) ([]*Item, error) {
+	return c.items.getItems(ctx, metadataNamespaces...)
+}
+
 // GetItemState returns an openHAB item state from its name. It's a shortcut of GetItem() => item.State().
 // The very first call of GetItemState will try to load the items collection from openHAB.
 func (c *Client) GetItemState(name string) (State, error) {
@@ -200,14 +221,14 @@ func (c *Client) GetMembersContext(ctx context.Context, groupName string) ([]*It
 }
 
 // SendCommand sends a command to an item. It's a shortcut for GetItem() => item.SendCommand().
-func (c *Client) SendCommand(itemName string, command State) error {
+func (c *Client) SendCommand(itemName string, command Command) error {
 	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
 	defer cancel()
 	return c.SendCommandContext(ctx, itemName, command)
 }
 
 // SendCommandContext sends a command to an item. It's a shortcut for GetItem() => item.SendCommandContext().
-func (c *Client) SendCommandContext(ctx context.Context, itemName string, command State) error {
+func (c *Client) SendCommandContext(ctx context.Context, itemName string, command Command) error {
 	item, err := c.items.getItem(ctx, itemName)
 	if err != nil {
 		return err
@@ -219,7 +240,7 @@ func (c *Client) SendCommandContext(ctx context.Context, itemName string, comman
 // It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
 // It returns false in case the acknowledged value is different than the command, or after timeout.
 // It's a shortcut for GetItem() => item.SendCommandWait().
-func (c *Client) SendCommandWait(itemName string, command State, timeout time.Duration) (bool, error) {
+func (c *Client) SendCommandWait(itemName string, command Command, timeout time.Duration) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -230,7 +251,7 @@ func (c *Client) SendCommandWait(itemName string, command State, timeout time.Du
 // It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
 // It returns false in case the acknowledged value is different than the command, or after timeout.
 // It's a shortcut for GetItem() => item.SendCommandWaitContext().
-func (c *Client) SendCommandWaitContext(ctx context.Context, itemName string, command State) (bool, error) {
+func (c *Client) SendCommandWaitContext(ctx context.Context, itemName string, command Command) (bool, error) {
 	item, err := c.items.getItem(ctx, itemName)
 	if err != nil {
 		return false, err
@@ -238,6 +259,75 @@ func (c *Client) SendCommandWaitContext(ctx context.Context, itemName string, co
 	return item.SendCommandWaitContext(ctx, command)
 }
 
+// PostUpdate updates the state of an item without sending a command. It's a shortcut for GetItem() => item.PostUpdate().
+func (c *Client) PostUpdate(itemName string, state State) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.PostUpdateContext(ctx, itemName, state)
+}
+
+// PostUpdateContext updates the state of an item without sending a command. It's a shortcut for GetItem() => item.PostUpdateContext().
+func (c *Client) PostUpdateContext(ctx context.Context, itemName string, state State) error {
+	item, err := c.items.getItem(ctx, itemName)
+	if err != nil {
+		return err
+	}
+	return item.PostUpdateContext(ctx, state)
+}
+
+// PostUpdateWait updates the state of an item and wait until the event bus acknowledge receiving the state, or after a timeout
+// It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
+// It returns false in case the acknowledged value is different than the state, or after timeout.
+// It's a shortcut for GetItem() => item.PostUpdateWait().
+func (c *Client) PostUpdateWait(itemName string, state State, timeout time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return c.PostUpdateWaitContext(ctx, itemName, state)
+}
+
+// PostUpdateWaitContext updates the state of an item and wait until the event bus acknowledge receiving the state, or after a timeout
+// It returns true if openHAB acknowledge it's setting the desired state to the item (even if it's the same value as before).
+// It returns false in case the acknowledged value is different than the state, or after timeout.
+// It's a shortcut for GetItem() => item.PostUpdateWaitContext().
+func (c *Client) PostUpdateWaitContext(ctx context.Context, itemName string, state State) (bool, error) {
+	item, err := c.items.getItem(ctx, itemName)
+	if err != nil {
+		return false, err
+	}
+	return item.PostUpdateWaitContext(ctx, state)
+}
+
+// GetThing returns an openHAB thing from its UID.
+// The very first call of GetThing will try to load the things collection from openHAB.
+// If not found, returns an openhab.ErrorNotFound error.
+func (c *Client) GetThing(uid string) (*Thing, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetThingContext(ctx, uid)
+}
+
+// GetThingContext returns an openHAB thing from its UID.
+// The very first call of GetThingContext will try to load the things collection from openHAB.
+// If not found, returns an openhab.ErrorNotFound error.
+func (c *Client) GetThingContext(ctx context.Context, uid string) (*Thing, error) {
+	return c.things.getThing(ctx, uid)
+}
+
+// GetThings returns all the things defined in openHAB.
+// The very first call of GetThings will try to load the things collection from openHAB.
+func (c *Client) GetThings() ([]*Thing, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetThingsContext(ctx)
+}
+
+// GetThingsContext returns all the things defined in openHAB.
+// The very first call of GetThingsContext will try to load the things collection from openHAB.
+func (c *Client) GetThingsContext(ctx context.Context) ([]*Thing, error) {
+	return c.things.getThings(ctx)
+}
+
 func (c *Client) get(ctx context.Context, url, contentType string) (*http.Response, error) {
 	debuglog.Printf("GET: %s", c.baseURL+url)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+url, http.NoBody)
@@ -252,16 +342,7 @@ func (c *Client) get(ctx context.Context, url, contentType string) (*http.Respon
 		return resp, err
 	}
 
-	if resp.StatusCode >= 400 {
-		switch resp.StatusCode {
-		case http.StatusNotFound:
-			return resp, ErrNotFound
-		default:
-			return resp, errors.New(resp.Status)
-		}
-	}
-
-	return resp, nil
+	return resp, statusError(resp)
 }
 
 func (c *Client) getString(ctx context.Context, url string) (string, error) {
@@ -310,16 +391,75 @@ func (c *Client) postString(ctx context.Context, url, value string) error {
 	// we don't expect any body in the response
 	resp.Body.Close()
 
-	if resp.StatusCode >= http.StatusBadRequest {
-		switch resp.StatusCode {
-		case http.StatusNotFound:
-			return ErrNotFound
-		default:
-			return errors.New(resp.Status)
-		}
+	return statusError(resp)
+}
+
+func (c *Client) postJSON(ctx context.Context, url string, value, result interface{}) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
 	}
+	return c.send(ctx, http.MethodPost, url, "application/json", bytes.NewReader(body), result)
+}
 
-	return nil
+func (c *Client) putString(ctx context.Context, url, value string, result interface{}) error {
+	return c.send(ctx, http.MethodPut, url, "text/plain", strings.NewReader(value), result)
+}
+
+func (c *Client) putJSON(ctx context.Context, url string, value, result interface{}) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.send(ctx, http.MethodPut, url, "application/json", bytes.NewReader(body), result)
+}
+
+// send a request with a body and decodes the JSON response into result (if not nil)
+func (c *Client) send(ctx context.Context, method, url, contentType string, body io.Reader, result interface{}) error {
+	debuglog.Printf("%s: %s", method, c.baseURL+url)
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+url, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.user, c.password)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = statusError(resp)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	decoder := json.NewDecoder(resp.Body)
+	return decoder.Decode(result)
+}
+
+// statusError converts a HTTP error status into an error.
+// It returns nil for a successful response.
+func statusError(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusBadRequest:
+		return ErrBadRequest
+	default:
+		return errors.New(resp.Status)
+	}
 }
 
 // listenEvents listen to the events from the REST api and send them to the event bus.
@@ -343,8 +483,20 @@ func (c *Client) listenEvents() error {
 		c.userEventBus.Publish(event.NewSystemEvent(event.TypeClientDisconnected))
 	}()
 
+	err = readEventStream(resp.Body, c.dispatchRawEvent)
+	if err != nil {
+		// send error event
+		c.userEventBus.Publish(event.NewErrorEvent(err))
+		return err
+	}
+	return nil
+}
+
+// readEventStream reads the server-sent events and calls dispatch with the data of each event.
+// The method returns when the stream is closed.
+func readEventStream(body io.Reader, dispatch func(data string)) error {
 	state := 0
-	scanner := bufio.NewScanner(resp.Body)
+	scanner := bufio.NewScanner(body)
 	for scanner.Scan() {
 		state++
 		line := scanner.Text()
@@ -372,18 +524,12 @@ func (c *Client) listenEvents() error {
 			}
 			data := strings.TrimPrefix(line, eventData)
 			if data != "" {
-				c.dispatchRawEvent(data)
+				dispatch(data)
 			}
 			continue
 		}
 	}
-
-	if err := scanner.Err(); err != nil {
-		// send error event
-		c.userEventBus.Publish(event.NewErrorEvent(err))
-		return err
-	}
-	return nil
+	return scanner.Err()
 }
 
 func (c *Client) dispatchRawEvent(data string) {
@@ -467,6 +613,20 @@ func (c *Client) unsubscribe(subID int) {
 	c.userEventBus.Unsubscribe(subID)
 }
 
+// isMemberOf returns true if the item is a direct member of the group.
+// The membership is resolved from the item cache only: an unknown item is never loaded from openHAB.
+func (c *Client) isMemberOf(itemName, groupName string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+
+	member, err := c.items.isMemberOf(ctx, itemName, groupName)
+	if err != nil {
+		errorlog.Printf("isMemberOf: %s", err)
+		return false
+	}
+	return member
+}
+
 func (c *Client) loadIndex() {
 	index := internal.RestIndex{}
 	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
@@ -623,9 +783,30 @@ func (c *Client) addInternalRules() {
 		c.subscribeSystem("", event.TypeItemState, func(e event.Event) {
 			c.itemStateUpdated(e)
 		})
+		c.subscribeSystem("", event.TypeGroupItemStateUpdated, func(e event.Event) {
+			c.itemStateUpdated(e)
+		})
 		c.subscribeSystem("", event.TypeItemRemoved, func(e event.Event) {
 			c.itemRemoved(e)
 		})
+		c.subscribeSystem("", event.TypeItemAdded, func(e event.Event) {
+			c.itemUpdated(e)
+		})
+		c.subscribeSystem("", event.TypeItemUpdated, func(e event.Event) {
+			c.itemUpdated(e)
+		})
+		c.subscribeSystem("", event.TypeThingStatusInfo, func(e event.Event) {
+			c.thingStatusUpdated(e)
+		})
+		c.subscribeSystem("", event.TypeThingStatusInfoChanged, func(e event.Event) {
+			c.thingStatusUpdated(e)
+		})
+		c.subscribeSystem("", event.TypeThingUpdated, func(e event.Event) {
+			c.thingUpdated(e)
+		})
+		c.subscribeSystem("", event.TypeThingRemoved, func(e event.Event) {
+			c.thingRemoved(e)
+		})
 	})
 }
 
@@ -685,24 +866,88 @@ func (c *Client) waitFinishingRules() {
 }
 
 func (c *Client) itemStateUpdated(e event.Event) {
-	if ev, ok := e.(event.ItemReceivedState); ok {
+	var itemName, state string
+	switch ev := e.(type) {
+	case event.ItemReceivedState:
+		itemName, state = ev.ItemName, ev.State
+	case event.GroupItemStateUpdated:
+		itemName, state = ev.ItemName, ev.State
+	default:
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+
+	item, err := c.items.getItem(ctx, itemName)
+	if err != nil {
+		errorlog.Printf("itemStateUpdated: %s", err)
+		return
+	}
+	item.setInternalStateString(state)
+	c.items.addHistory(itemName, item.getInternalState(), item.Updated())
+	c.addCounter(MetricItemStateUpdated, 1, MetricItemName, itemName)
+}
+
+func (c *Client) itemRemoved(e event.Event) {
+	if ev, ok := e.(event.ItemRemoved); ok {
+		c.items.removeItem(ev.Item.Name)
+		c.items.dropHistory(ev.Item.Name)
+		// c.addCounter(MetricItemRemoved, 1, MetricItemName, ev.Item.Name)
+	}
+}
+
+func (c *Client) itemUpdated(e event.Event) {
+	switch ev := e.(type) {
+	case event.ItemAdded:
+		c.items.setItemFromEvent(ev.Item)
+	case event.ItemUpdated:
+		c.items.setItemFromEvent(ev.Item)
+	}
+}
+
+func (c *Client) thingStatusUpdated(e event.Event) {
+	var uid string
+	var status event.ThingStatus
+	switch ev := e.(type) {
+	case event.ThingStatusInfoEvent:
+		uid = ev.ThingName
+		status = event.ThingStatus{Status: ev.Status, StatusDetail: ev.StatusDetail, Description: ev.Description}
+	case event.ThingStatusInfoChangedEvent:
+		uid = ev.ThingName
+		status = event.ThingStatus{Status: ev.NewStatus, StatusDetail: ev.NewStatusDetail, Description: ev.NewDescription}
+	default:
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+
+	thing, err := c.things.getThing(ctx, uid)
+	if err != nil {
+		errorlog.Printf("thingStatusUpdated: %s", err)
+		return
+	}
+	thing.setStatus(status)
+	c.addCounter(MetricThingUpdated, 1, MetricThingUID, uid)
+}
+
+func (c *Client) thingUpdated(e event.Event) {
+	if ev, ok := e.(event.ThingUpdated); ok {
 		ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
 		defer cancel()
 
-		item, err := c.items.getItem(ctx, ev.ItemName)
+		thing, err := c.things.getThing(ctx, ev.Thing.UID)
 		if err != nil {
-			errorlog.Printf("itemStateUpdated: %s", err)
+			errorlog.Printf("thingUpdated: %s", err)
 			return
 		}
-		item.setInternalStateString(ev.State)
-		c.addCounter(MetricItemStateUpdated, 1, MetricItemName, ev.ItemName)
+		thing.setFromEvent(ev.Thing)
+		c.addCounter(MetricThingUpdated, 1, MetricThingUID, ev.Thing.UID)
 	}
 }
 
-func (c *Client) itemRemoved(e event.Event) {
-	if ev, ok := e.(event.ItemRemoved); ok {
-		c.items.removeItem(ev.Item.Name)
-		// c.addCounter(MetricItemRemoved, 1, MetricItemName, ev.Item.Name)
+func (c *Client) thingRemoved(e event.Event) {
+	if ev, ok := e.(event.ThingRemoved); ok {
+		c.things.removeThing(ev.Thing.UID)
 	}
 }
 
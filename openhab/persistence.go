@@ -0,0 +1,201 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+const (
+	persistencePath      = "persistence/"
+	persistenceItemsPath = persistencePath + "items/"
+	// persistenceTimeFormat is the format of the time parameters of the persistence API
+	persistenceTimeFormat = "2006-01-02T15:04:05.000-0700"
+)
+
+// HistoricState is a state of an item saved by a persistence service
+type HistoricState struct {
+	Time  time.Time
+	State State
+}
+
+// GetPersistenceServices returns the persistence services installed in openHAB
+func (c *Client) GetPersistenceServices() ([]api.PersistenceService, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetPersistenceServicesContext(ctx)
+}
+
+// GetPersistenceServicesContext returns the persistence services installed in openHAB
+func (c *Client) GetPersistenceServicesContext(ctx context.Context) ([]api.PersistenceService, error) {
+	services := make([]api.PersistenceService, 0)
+	err := c.getJSON(ctx, "persistence", &services)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load persistence services: %w", err)
+	}
+	return services, nil
+}
+
+// GetItemHistory returns the states of the item persisted between start and end, sorted by time.
+// The states are typed from the item type, like a DecimalState for a Number item.
+//
+// A zero start or end time is using the openHAB default (from one day before the end time, up to now).
+// An empty serviceID is selecting the default persistence service.
+func (c *Client) GetItemHistory(itemName string, start, end time.Time, serviceID string) ([]HistoricState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetItemHistoryContext(ctx, itemName, start, end, serviceID)
+}
+
+// GetItemHistoryContext returns the states of the item persisted between start and end, sorted by time.
+// The states are typed from the item type, like a DecimalState for a Number item.
+//
+// A zero start or end time is using the openHAB default (from one day before the end time, up to now).
+// An empty serviceID is selecting the default persistence service.
+func (c *Client) GetItemHistoryContext(ctx context.Context, itemName string, start, end time.Time, serviceID string) ([]HistoricState, error) {
+	item, err := c.items.getItem(ctx, itemName)
+	if err != nil {
+		return nil, err
+	}
+	return item.history(ctx, start, end, serviceID, false)
+}
+
+// history loads the persisted states of the item.
+// With boundary, the states just before start and just after end are also returned.
+func (i *Item) history(ctx context.Context, start, end time.Time, serviceID string, boundary bool) ([]HistoricState, error) {
+	query := url.Values{}
+	if serviceID != "" {
+		query.Set("serviceId", serviceID)
+	}
+	if !start.IsZero() {
+		query.Set("starttime", start.Format(persistenceTimeFormat))
+	}
+	if !end.IsZero() {
+		query.Set("endtime", end.Format(persistenceTimeFormat))
+	}
+	if boundary {
+		query.Set("boundary", "true")
+	}
+	path := persistenceItemsPath + url.PathEscape(i.name)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	data := api.ItemHistory{}
+	err := i.client.getJSON(ctx, path, &data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load history of item %q: %w", i.name, err)
+	}
+	unit := i.persistedUnit()
+	states := make([]HistoricState, len(data.Data))
+	for index, point := range data.Data {
+		state := i.stateFromString(point.State)
+		if decimal, ok := state.(DecimalState); ok && decimal.Unit() == "" && unit != "" {
+			state = DecimalState{value: decimal.value, unit: unit}.withDimension(i.Dimension())
+		}
+		states[index] = HistoricState{
+			Time:  time.UnixMilli(point.Time),
+			State: state,
+		}
+	}
+	sort.SliceStable(states, func(i, j int) bool {
+		return states[i].Time.Before(states[j].Time)
+	})
+	return states, nil
+}
+
+// persistedUnit returns the unit of the persisted values of a "Number:<dimension>" item:
+// openHAB persists the values in the unit of the item, without the unit symbol.
+// The unit is taken from the state description pattern (like "%.1f °C"), or else from the current state.
+func (i *Item) persistedUnit() string {
+	if i.Type() != ItemTypeNumber || i.Dimension() == DimensionNone {
+		return ""
+	}
+	if description, ok := i.StateDescription(); ok {
+		fields := strings.Fields(description.Pattern)
+		if len(fields) > 1 && !strings.Contains(fields[len(fields)-1], "%") {
+			return fields[len(fields)-1]
+		}
+	}
+	if state, ok := i.getInternalState().(DecimalState); ok {
+		return state.Unit()
+	}
+	return ""
+}
+
+// StoreItemHistory saves the states of the item at their time into the persistence service.
+// The states are sent one by one, each request with its own TimeoutHTTP, so a large batch is not limited by a single timeout.
+// It stops at the first error and returns the number of states saved, which is also the index of the state that failed.
+// An empty serviceID is selecting the default persistence service, which must be modifiable.
+func (c *Client) StoreItemHistory(itemName string, states []HistoricState, serviceID string) (int, error) {
+	for index, state := range states {
+		err := func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+			defer cancel()
+			return c.storeItemState(ctx, itemName, state, serviceID)
+		}()
+		if err != nil {
+			return index, err
+		}
+	}
+	return len(states), nil
+}
+
+// StoreItemHistoryContext saves the states of the item at their time into the persistence service.
+// The states are sent one by one, all within the context.
+// It stops at the first error and returns the number of states saved, which is also the index of the state that failed.
+// An empty serviceID is selecting the default persistence service, which must be modifiable.
+func (c *Client) StoreItemHistoryContext(ctx context.Context, itemName string, states []HistoricState, serviceID string) (int, error) {
+	for index, state := range states {
+		err := c.storeItemState(ctx, itemName, state, serviceID)
+		if err != nil {
+			return index, err
+		}
+	}
+	return len(states), nil
+}
+
+// storeItemState saves one state of the item into the persistence service
+func (c *Client) storeItemState(ctx context.Context, itemName string, state HistoricState, serviceID string) error {
+	if state.State == nil {
+		return fmt.Errorf("cannot store an empty state of item %q at %s: %w", itemName, state.Time, ErrBadRequest)
+	}
+	query := url.Values{}
+	if serviceID != "" {
+		query.Set("serviceId", serviceID)
+	}
+	query.Set("time", state.Time.Format(persistenceTimeFormat))
+	query.Set("state", state.State.String())
+	err := c.send(ctx, http.MethodPut, persistenceItemsPath+url.PathEscape(itemName)+"?"+query.Encode(), "", http.NoBody, nil)
+	if err != nil {
+		return fmt.Errorf("cannot store state %q of item %q at %s: %w", state.State, itemName, state.Time, err)
+	}
+	return nil
+}
+
+// DeleteItemHistory removes the states of the item persisted between start and end from the persistence service.
+// openHAB requires the service ID, and the service must be modifiable.
+func (c *Client) DeleteItemHistory(itemName string, start, end time.Time, serviceID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.DeleteItemHistoryContext(ctx, itemName, start, end, serviceID)
+}
+
+// DeleteItemHistoryContext removes the states of the item persisted between start and end from the persistence service.
+// openHAB requires the service ID, and the service must be modifiable.
+func (c *Client) DeleteItemHistoryContext(ctx context.Context, itemName string, start, end time.Time, serviceID string) error {
+	query := url.Values{}
+	query.Set("serviceId", serviceID)
+	query.Set("starttime", start.Format(persistenceTimeFormat))
+	query.Set("endtime", end.Format(persistenceTimeFormat))
+	err := c.send(ctx, http.MethodDelete, persistenceItemsPath+url.PathEscape(itemName)+"?"+query.Encode(), "", http.NoBody, nil)
+	if err != nil {
+		return fmt.Errorf("cannot delete history of item %q: %w", itemName, err)
+	}
+	return nil
+}
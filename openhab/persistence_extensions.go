@@ -0,0 +1,299 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ItemPersistence gives access to the persistence extensions of an item, like in the openHAB rules:
+//
+//	average, err := item.Persistence("").AverageSince(time.Now().Add(-time.Hour))
+type ItemPersistence struct {
+	item      *Item
+	serviceID string
+}
+
+// Persistence returns the persistence extensions of the item using this persistence service.
+// An empty serviceID is selecting the default persistence service.
+func (i *Item) Persistence(serviceID string) *ItemPersistence {
+	return &ItemPersistence{
+		item:      i,
+		serviceID: serviceID,
+	}
+}
+
+// HistoricState returns the persisted state in effect at that time (the last state persisted before or at that time).
+// A state persisted before that time is returned with its time set to that time, like openHAB returns it.
+// It returns ErrNotFound if no state was persisted before that time.
+func (p *ItemPersistence) HistoricState(at time.Time) (HistoricState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.HistoricStateContext(ctx, at)
+}
+
+// HistoricStateContext returns the persisted state in effect at that time (the last state persisted before or at that time).
+// A state persisted before that time is returned with its time set to that time, like openHAB returns it.
+// It returns ErrNotFound if no state was persisted before that time.
+func (p *ItemPersistence) HistoricStateContext(ctx context.Context, at time.Time) (HistoricState, error) {
+	// openHAB sets the time of the boundary states to the start and end of the query:
+	// querying up to the next millisecond keeps the state persisted after that time out of the result
+	states, err := p.item.history(ctx, at, at.Add(time.Millisecond), p.serviceID, true)
+	if err != nil {
+		return HistoricState{}, err
+	}
+	for index := len(states) - 1; index >= 0; index-- {
+		if !states[index].Time.After(at) {
+			return states[index], nil
+		}
+	}
+	return HistoricState{}, fmt.Errorf("no state of item %q persisted before %s: %w", p.item.name, at, ErrNotFound)
+}
+
+// AverageSince returns the average of the persisted states since that time, counting the state in effect at that time.
+// Each state counts the same regardless of its duration: see TimeWeightedAverageSince.
+// The average is in the unit of the first state.
+func (p *ItemPersistence) AverageSince(since time.Time) (DecimalState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.AverageSinceContext(ctx, since)
+}
+
+// AverageSinceContext returns the average of the persisted states since that time, counting the state in effect at that time.
+// Each state counts the same regardless of its duration: see TimeWeightedAverageSinceContext.
+// The average is in the unit of the first state.
+func (p *ItemPersistence) AverageSinceContext(ctx context.Context, since time.Time) (DecimalState, error) {
+	points, unit, err := p.decimalPointsSince(ctx, since, true)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	sum := 0.0
+	for _, point := range points {
+		sum += point.value
+	}
+	return DecimalState{value: sum / float64(len(points)), unit: unit}, nil
+}
+
+// TimeWeightedAverageSince returns the average of the persisted states since that time,
+// each state being weighted by the time it was in effect (the last one until now).
+// The average is in the unit of the first state.
+func (p *ItemPersistence) TimeWeightedAverageSince(since time.Time) (DecimalState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.TimeWeightedAverageSinceContext(ctx, since)
+}
+
+// TimeWeightedAverageSinceContext returns the average of the persisted states since that time,
+// each state being weighted by the time it was in effect (the last one until now).
+// The average is in the unit of the first state.
+func (p *ItemPersistence) TimeWeightedAverageSinceContext(ctx context.Context, since time.Time) (DecimalState, error) {
+	points, unit, err := p.decimalPointsSince(ctx, since, true)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	now := time.Now()
+	sum := 0.0
+	total := time.Duration(0)
+	for index, point := range points {
+		end := now
+		if index+1 < len(points) {
+			end = points[index+1].state.Time
+		}
+		duration := end.Sub(point.state.Time)
+		sum += point.value * duration.Seconds()
+		total += duration
+	}
+	if total <= 0 {
+		// all the states are persisted now
+		return DecimalState{value: points[len(points)-1].value, unit: unit}, nil
+	}
+	return DecimalState{value: sum / total.Seconds(), unit: unit}, nil
+}
+
+// MinimumSince returns the lowest persisted state since that time, counting the state in effect at that time.
+func (p *ItemPersistence) MinimumSince(since time.Time) (HistoricState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.MinimumSinceContext(ctx, since)
+}
+
+// MinimumSinceContext returns the lowest persisted state since that time, counting the state in effect at that time.
+func (p *ItemPersistence) MinimumSinceContext(ctx context.Context, since time.Time) (HistoricState, error) {
+	return p.extremeSince(ctx, since, func(value, extreme float64) bool { return value < extreme })
+}
+
+// MaximumSince returns the highest persisted state since that time, counting the state in effect at that time.
+func (p *ItemPersistence) MaximumSince(since time.Time) (HistoricState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.MaximumSinceContext(ctx, since)
+}
+
+// MaximumSinceContext returns the highest persisted state since that time, counting the state in effect at that time.
+func (p *ItemPersistence) MaximumSinceContext(ctx context.Context, since time.Time) (HistoricState, error) {
+	return p.extremeSince(ctx, since, func(value, extreme float64) bool { return value > extreme })
+}
+
+// SumSince returns the sum of the states persisted since that time (the state in effect at that time is not counted).
+// The sum is in the unit of the first state.
+func (p *ItemPersistence) SumSince(since time.Time) (DecimalState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.SumSinceContext(ctx, since)
+}
+
+// SumSinceContext returns the sum of the states persisted since that time (the state in effect at that time is not counted).
+// The sum is in the unit of the first state.
+func (p *ItemPersistence) SumSinceContext(ctx context.Context, since time.Time) (DecimalState, error) {
+	points, unit, err := p.decimalPointsSince(ctx, since, false)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	sum := 0.0
+	for _, point := range points {
+		sum += point.value
+	}
+	return DecimalState{value: sum, unit: unit}, nil
+}
+
+// DeltaSince returns the difference between the current state and the state in effect at that time,
+// in the unit of the current state.
+func (p *ItemPersistence) DeltaSince(since time.Time) (DecimalState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.DeltaSinceContext(ctx, since)
+}
+
+// DeltaSinceContext returns the difference between the current state and the state in effect at that time,
+// in the unit of the current state.
+func (p *ItemPersistence) DeltaSinceContext(ctx context.Context, since time.Time) (DecimalState, error) {
+	historic, err := p.HistoricStateContext(ctx, since)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	state, err := p.item.StateContext(ctx)
+	if err != nil {
+		return DecimalState{}, err
+	}
+	current, ok := state.(DecimalState)
+	if !ok {
+		return DecimalState{}, fmt.Errorf("state %q of item %q: %w", state, p.item.name, ErrNotDecimal)
+	}
+	previous, ok := historic.State.(DecimalState)
+	if !ok {
+		return DecimalState{}, fmt.Errorf("persisted state %q of item %q: %w", historic.State, p.item.name, ErrNotDecimal)
+	}
+	return current.Subtract(previous)
+}
+
+// ChangedSince returns true if the persisted state changed since that time
+func (p *ItemPersistence) ChangedSince(since time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.ChangedSinceContext(ctx, since)
+}
+
+// ChangedSinceContext returns true if the persisted state changed since that time
+func (p *ItemPersistence) ChangedSinceContext(ctx context.Context, since time.Time) (bool, error) {
+	states, err := p.statesSince(ctx, since, true)
+	if err != nil {
+		return false, err
+	}
+	for index := 1; index < len(states); index++ {
+		if states[index].State.String() != states[index-1].State.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdatedSince returns true if a state was persisted since that time, even without a change of state
+func (p *ItemPersistence) UpdatedSince(since time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.item.client.config.TimeoutHTTP)
+	defer cancel()
+	return p.UpdatedSinceContext(ctx, since)
+}
+
+// UpdatedSinceContext returns true if a state was persisted since that time, even without a change of state
+func (p *ItemPersistence) UpdatedSinceContext(ctx context.Context, since time.Time) (bool, error) {
+	states, err := p.statesSince(ctx, since, false)
+	if err != nil {
+		return false, err
+	}
+	return len(states) > 0, nil
+}
+
+// statesSince returns the states persisted since that time, up to now.
+// With boundary, the state in effect at that time is returned first, with its time set to since.
+func (p *ItemPersistence) statesSince(ctx context.Context, since time.Time, boundary bool) ([]HistoricState, error) {
+	now := time.Now()
+	// see HistoricStateContext: the boundary state after the end of the query is stamped with the end time
+	states, err := p.item.history(ctx, since, now.Add(time.Millisecond), p.serviceID, boundary)
+	if err != nil {
+		return nil, err
+	}
+	found := make([]HistoricState, 0, len(states))
+	for _, state := range states {
+		if state.Time.After(now) {
+			break
+		}
+		if state.Time.Before(since) {
+			state.Time = since
+		}
+		found = append(found, state)
+	}
+	return found, nil
+}
+
+// decimalPoint is a persisted decimal state with its value converted to a common unit
+type decimalPoint struct {
+	value float64
+	state HistoricState
+}
+
+// decimalPointsSince returns the decimal states persisted since that time, converted into the unit of the first state.
+// The undefined states are ignored. It returns ErrNotFound if there's no decimal state.
+func (p *ItemPersistence) decimalPointsSince(ctx context.Context, since time.Time, boundary bool) ([]decimalPoint, string, error) {
+	states, err := p.statesSince(ctx, since, boundary)
+	if err != nil {
+		return nil, "", err
+	}
+	points := make([]decimalPoint, 0, len(states))
+	unit := ""
+	for _, state := range states {
+		if IsUndefined(state.State) {
+			continue
+		}
+		decimal, ok := state.State.(DecimalState)
+		if !ok {
+			return nil, "", fmt.Errorf("persisted state %q of item %q: %w", state.State, p.item.name, ErrNotDecimal)
+		}
+		if len(points) == 0 {
+			unit = decimal.Unit()
+		}
+		value, err := convertUnit(decimal.Float64(), decimal.Unit(), unit)
+		if err != nil {
+			return nil, "", err
+		}
+		points = append(points, decimalPoint{value: value, state: state})
+	}
+	if len(points) == 0 {
+		return nil, "", fmt.Errorf("no decimal state of item %q persisted since %s: %w", p.item.name, since, ErrNotFound)
+	}
+	return points, unit, nil
+}
+
+// extremeSince returns the persisted state selected by the better function
+func (p *ItemPersistence) extremeSince(ctx context.Context, since time.Time, better func(value, extreme float64) bool) (HistoricState, error) {
+	points, _, err := p.decimalPointsSince(ctx, since, true)
+	if err != nil {
+		return HistoricState{}, err
+	}
+	extreme := points[0]
+	for _, point := range points[1:] {
+		if better(point.value, extreme.value) {
+			extreme = point
+		}
+	}
+	return extreme.state, nil
+}
@@ -0,0 +1,208 @@
+package openhab
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistenceExtensions(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Millisecond)
+	server := newTestServer(t, api.Item{Name: "Temperature", Type: "Number:Temperature", State: "22 °C"})
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j"}))
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "influxdb"}))
+	// openHAB persists the values without unit
+	require.NoError(t, server.AddItemHistory("rrd4j", "Temperature",
+		api.HistoryDataBean{Time: now.Add(-3 * time.Hour).UnixMilli(), State: "16"},
+		api.HistoryDataBean{Time: now.Add(-2 * time.Hour).UnixMilli(), State: "18"},
+		api.HistoryDataBean{Time: now.Add(-1 * time.Hour).UnixMilli(), State: "20.5"},
+		api.HistoryDataBean{Time: now.Add(-30 * time.Minute).UnixMilli(), State: "UNDEF"},
+	))
+	require.NoError(t, server.AddItemHistory("influxdb", "Temperature",
+		api.HistoryDataBean{Time: now.Add(-3 * time.Hour).UnixMilli(), State: "10"},
+	))
+
+	client := NewClient(Config{URL: server.URL()})
+	item, err := client.GetItem("Temperature")
+	require.NoError(t, err)
+	persistence := item.Persistence("")
+	since := now.Add(-150 * time.Minute)
+
+	// the state persisted before is returned at the time asked for
+	historic, err := persistence.HistoricState(now.Add(-90 * time.Minute))
+	require.NoError(t, err)
+	assert.True(t, now.Add(-90*time.Minute).Equal(historic.Time))
+	assert.Equal(t, MustParseDecimalState("18 °C"), historic.State)
+
+	// the average is not rounded
+	average, err := persistence.AverageSince(since)
+	require.NoError(t, err)
+	assert.Equal(t, "°C", average.Unit())
+	assert.InDelta(t, 54.5/3, average.Float64(), 1e-9)
+
+	// 16 °C for 30 minutes, 18 °C for 1 hour and 20.5 °C for 1 hour until now (the undefined state is ignored)
+	average, err = persistence.TimeWeightedAverageSince(since)
+	require.NoError(t, err)
+	assert.Equal(t, "°C", average.Unit())
+	assert.InDelta(t, 18.6, average.Float64(), 0.001)
+
+	minimum, err := persistence.MinimumSince(since)
+	require.NoError(t, err)
+	assert.True(t, since.Equal(minimum.Time))
+	assert.Equal(t, MustParseDecimalState("16 °C"), minimum.State)
+
+	maximum, err := persistence.MaximumSince(since)
+	require.NoError(t, err)
+	assert.True(t, now.Add(-time.Hour).Equal(maximum.Time))
+	assert.Equal(t, MustParseDecimalState("20.5 °C"), maximum.State)
+
+	// the state in effect at since is not counted
+	sum, err := persistence.SumSince(since)
+	require.NoError(t, err)
+	assert.Equal(t, MustParseDecimalState("38.5 °C"), sum)
+
+	delta, err := persistence.DeltaSince(since)
+	require.NoError(t, err)
+	assert.Equal(t, MustParseDecimalState("6 °C"), delta)
+
+	changed, err := persistence.ChangedSince(since)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = persistence.ChangedSince(now.Add(-20 * time.Minute))
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	updated, err := persistence.UpdatedSince(now.Add(-45 * time.Minute))
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	updated, err = persistence.UpdatedSince(now.Add(-20 * time.Minute))
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	// another service
+	historic, err = item.Persistence("influxdb").HistoricState(now)
+	require.NoError(t, err)
+	assert.Equal(t, MustParseDecimalState("10 °C"), historic.State)
+
+	assert.NoError(t, server.PersistenceErr())
+}
+
+func TestPersistenceExtensionsStatePersistedAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Millisecond)
+	server := newTestServer(t, api.Item{Name: "Temperature", Type: "Number:Temperature", State: "22 °C"})
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j"}))
+	require.NoError(t, server.AddItemHistory("rrd4j", "Temperature",
+		api.HistoryDataBean{Time: now.Add(-3 * time.Hour).UnixMilli(), State: "16"},
+		api.HistoryDataBean{Time: now.Add(-1 * time.Hour).UnixMilli(), State: "20"},
+	))
+
+	client := NewClient(Config{URL: server.URL()})
+	item, err := client.GetItem("Temperature")
+	require.NoError(t, err)
+	persistence := item.Persistence("")
+	at := now.Add(-2 * time.Hour)
+
+	// the state persisted after that time is not in effect yet
+	historic, err := persistence.HistoricState(at)
+	require.NoError(t, err)
+	assert.Equal(t, MustParseDecimalState("16 °C"), historic.State)
+
+	delta, err := persistence.DeltaSince(at)
+	require.NoError(t, err)
+	assert.Equal(t, MustParseDecimalState("6 °C"), delta)
+
+	// the state persisted at that time is in effect
+	historic, err = persistence.HistoricState(now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.True(t, now.Add(-time.Hour).Equal(historic.Time))
+	assert.Equal(t, MustParseDecimalState("20 °C"), historic.State)
+
+	assert.NoError(t, server.PersistenceErr())
+}
+
+func TestPersistenceExtensionsUnitFromStateDescription(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Millisecond)
+	server := newTestServer(t, api.Item{
+		Name:             "Outside",
+		Type:             "Number:Temperature",
+		State:            "20 °C",
+		StateDescription: &api.StateDescription{Pattern: "%.1f °F"},
+	})
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j"}))
+	require.NoError(t, server.AddItemHistory("rrd4j", "Outside",
+		api.HistoryDataBean{Time: now.Add(-3 * time.Hour).UnixMilli(), State: "50"},
+	))
+
+	client := NewClient(Config{URL: server.URL()})
+	item, err := client.GetItem("Outside")
+	require.NoError(t, err)
+	persistence := item.Persistence("")
+
+	// the persisted values are in the unit of the state description pattern
+	historic, err := persistence.HistoricState(now)
+	require.NoError(t, err)
+	assert.Equal(t, MustParseDecimalState("50 °F"), historic.State)
+
+	// 20 °C - 50 °F (10 °C)
+	delta, err := persistence.DeltaSince(now)
+	require.NoError(t, err)
+	assert.Equal(t, "°C", delta.Unit())
+	assert.InDelta(t, 10, delta.Float64(), 1e-9)
+
+	assert.NoError(t, server.PersistenceErr())
+}
+
+func TestPersistenceExtensionsErrors(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Millisecond)
+	server := newTestServer(t,
+		api.Item{Name: "Temperature", Type: "Number:Temperature", State: "22 °C"},
+		api.Item{Name: "Light", Type: "Switch", State: "ON"},
+	)
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j"}))
+	require.NoError(t, server.AddItemHistory("rrd4j", "Temperature",
+		api.HistoryDataBean{Time: now.Add(-3 * time.Hour).UnixMilli(), State: "16"},
+	))
+	require.NoError(t, server.AddItemHistory("rrd4j", "Light",
+		api.HistoryDataBean{Time: now.Add(-20 * time.Minute).UnixMilli(), State: "OFF"},
+	))
+
+	client := NewClient(Config{URL: server.URL()})
+	temperature, err := client.GetItem("Temperature")
+	require.NoError(t, err)
+	light, err := client.GetItem("Light")
+	require.NoError(t, err)
+
+	_, err = temperature.Persistence("").HistoricState(now.Add(-4 * time.Hour))
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = temperature.Persistence("").SumSince(now.Add(-20 * time.Minute))
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = light.Persistence("").AverageSince(now.Add(-time.Hour))
+	assert.ErrorIs(t, err, ErrNotDecimal)
+
+	_, err = light.Persistence("").DeltaSince(now)
+	assert.ErrorIs(t, err, ErrNotDecimal)
+
+	_, err = temperature.Persistence("mapdb").MaximumSince(now.Add(-time.Hour))
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = temperature.Persistence("").MinimumSinceContext(ctx, now.Add(-time.Hour))
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,228 @@
+package openhab
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPersistenceServices(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t)
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j", Label: "RRD4j", Type: api.PersistenceServiceQueryable}))
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "influxdb", Label: "InfluxDB"}))
+
+	client := NewClient(Config{URL: server.URL()})
+	services, err := client.GetPersistenceServices()
+	require.NoError(t, err)
+	assert.Equal(t, []api.PersistenceService{
+		{ID: "rrd4j", Label: "RRD4j", Type: api.PersistenceServiceQueryable},
+		{ID: "influxdb", Label: "InfluxDB", Type: api.PersistenceServiceModifiable},
+	}, services)
+
+	assert.NoError(t, server.PersistenceErr())
+}
+
+func TestGetItemHistory(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Millisecond)
+	server := newTestServer(t,
+		api.Item{Name: "Temperature", Type: "Number:Temperature", State: "21 °C"},
+		api.Item{Name: "Light", Type: "Switch", State: "ON"},
+	)
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j", Type: api.PersistenceServiceQueryable}))
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "influxdb"}))
+	// openHAB persists the values without unit
+	require.NoError(t, server.AddItemHistory("rrd4j", "Temperature",
+		api.HistoryDataBean{Time: now.Add(-2 * time.Hour).UnixMilli(), State: "18"},
+		api.HistoryDataBean{Time: now.Add(-1 * time.Hour).UnixMilli(), State: "19.5"},
+		api.HistoryDataBean{Time: now.Add(-10 * time.Minute).UnixMilli(), State: "21"},
+	))
+	require.NoError(t, server.AddItemHistory("influxdb", "Temperature",
+		api.HistoryDataBean{Time: now.Add(-30 * time.Minute).UnixMilli(), State: "20"},
+	))
+	require.NoError(t, server.AddItemHistory("rrd4j", "Light",
+		api.HistoryDataBean{Time: now.Add(-20 * time.Minute).UnixMilli(), State: "OFF"},
+		api.HistoryDataBean{Time: now.Add(-5 * time.Minute).UnixMilli(), State: "ON"},
+	))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	fixtures := []struct {
+		name      string
+		itemName  string
+		start     time.Time
+		end       time.Time
+		serviceID string
+		expected  []HistoricState
+	}{
+		{
+			"default range and service",
+			"Temperature", time.Time{}, time.Time{}, "",
+			[]HistoricState{
+				{now.Add(-2 * time.Hour), MustParseDecimalState("18 °C")},
+				{now.Add(-1 * time.Hour), MustParseDecimalState("19.5 °C")},
+				{now.Add(-10 * time.Minute), MustParseDecimalState("21 °C")},
+			},
+		},
+		{
+			"range",
+			"Temperature", now.Add(-90 * time.Minute), now.Add(-30 * time.Minute), "",
+			[]HistoricState{
+				{now.Add(-1 * time.Hour), MustParseDecimalState("19.5 °C")},
+			},
+		},
+		{
+			"service",
+			"Temperature", now.Add(-time.Hour), time.Time{}, "influxdb",
+			[]HistoricState{
+				{now.Add(-30 * time.Minute), MustParseDecimalState("20 °C")},
+			},
+		},
+		{
+			"switch",
+			"Light", now.Add(-time.Hour), now, "rrd4j",
+			[]HistoricState{
+				{now.Add(-20 * time.Minute), SwitchOFF},
+				{now.Add(-5 * time.Minute), SwitchON},
+			},
+		},
+		{
+			"no data",
+			"Light", now.Add(-time.Hour), now, "influxdb",
+			[]HistoricState{},
+		},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			states, err := client.GetItemHistory(fixture.itemName, fixture.start, fixture.end, fixture.serviceID)
+			require.NoError(t, err)
+			require.Len(t, states, len(fixture.expected))
+			for i, expected := range fixture.expected {
+				assert.True(t, expected.Time.Equal(states[i].Time), "expected %s but found %s", expected.Time, states[i].Time)
+				assert.Equal(t, expected.State, states[i].State)
+			}
+		})
+	}
+
+	assert.NoError(t, server.PersistenceErr())
+}
+
+func TestGetItemHistoryErrors(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, api.Item{Name: "Temperature", Type: "Number:Temperature", State: "21 °C"})
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j"}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	_, err := client.GetItemHistory("Unknown", time.Time{}, time.Time{}, "")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = client.GetItemHistory("Temperature", time.Time{}, time.Time{}, "mapdb")
+	assert.ErrorIs(t, err, ErrBadRequest)
+}
+
+func TestStoreItemHistory(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Millisecond)
+	server := newTestServer(t, api.Item{Name: "Temperature", Type: "Number:Temperature", State: "21 °C"})
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j", Type: api.PersistenceServiceQueryable}))
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "influxdb"}))
+	require.NoError(t, server.AddItemHistory("influxdb", "Temperature",
+		api.HistoryDataBean{Time: now.Add(-30 * time.Minute).UnixMilli(), State: "20"},
+	))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	states := []HistoricState{
+		{now.Add(-45 * time.Minute), MustParseDecimalState("19 °C")},
+		{now.Add(-15 * time.Minute), MustParseDecimalState("21 °C")},
+		// replaces the existing state
+		{now.Add(-30 * time.Minute), MustParseDecimalState("20.5 °C")},
+	}
+	stored, err := client.StoreItemHistory("Temperature", states, "influxdb")
+	require.NoError(t, err)
+	assert.Equal(t, 3, stored)
+
+	history, err := client.GetItemHistory("Temperature", now.Add(-time.Hour), now, "influxdb")
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	for i, expected := range []HistoricState{states[0], states[2], states[1]} {
+		assert.True(t, expected.Time.Equal(history[i].Time))
+		assert.Equal(t, expected.State, history[i].State)
+	}
+
+	// the default service is not modifiable
+	stored, err = client.StoreItemHistory("Temperature", states, "")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, 0, stored)
+
+	// the index of the state that failed is returned
+	stored, err = client.StoreItemHistory("Temperature", []HistoricState{
+		{now.Add(-5 * time.Minute), MustParseDecimalState("22 °C")},
+		{Time: now},
+		{now, MustParseDecimalState("23 °C")},
+	}, "influxdb")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, 1, stored)
+
+	history, err = client.GetItemHistory("Temperature", now.Add(-time.Hour), now, "influxdb")
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	assert.Equal(t, MustParseDecimalState("22 °C"), history[3].State)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stored, err = client.StoreItemHistoryContext(ctx, "Temperature", states, "influxdb")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, stored)
+
+	assert.NoError(t, server.PersistenceErr())
+}
+
+func TestDeleteItemHistory(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Millisecond)
+	server := newTestServer(t, api.Item{Name: "Light", Type: "Switch", State: "ON"})
+	defer server.Close()
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "rrd4j", Type: api.PersistenceServiceQueryable}))
+	require.NoError(t, server.SetPersistenceService(api.PersistenceService{ID: "influxdb"}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	_, err := client.StoreItemHistory("Light", []HistoricState{
+		{now.Add(-3 * time.Hour), SwitchON},
+		{now.Add(-2 * time.Hour), SwitchOFF},
+		{now.Add(-1 * time.Hour), SwitchON},
+	}, "influxdb")
+	require.NoError(t, err)
+
+	err = client.DeleteItemHistory("Light", now.Add(-150*time.Minute), now.Add(-90*time.Minute), "influxdb")
+	require.NoError(t, err)
+
+	history, err := client.GetItemHistory("Light", now.Add(-4*time.Hour), now, "influxdb")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, SwitchON, history[0].State)
+	assert.Equal(t, SwitchON, history[1].State)
+	assert.True(t, now.Add(-time.Hour).Equal(history[1].Time))
+
+	// the service is mandatory
+	err = client.DeleteItemHistory("Light", now.Add(-4*time.Hour), now, "")
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	// not modifiable
+	err = client.DeleteItemHistory("Light", now.Add(-4*time.Hour), now, "rrd4j")
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	assert.NoError(t, server.PersistenceErr())
+}
@@ -0,0 +1,138 @@
+package openhab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+)
+
+const (
+	sitemapsPath       = "sitemaps/"
+	sitemapEventsPath  = sitemapsPath + "events/"
+	sitemapSubscribe   = sitemapEventsPath + "subscribe"
+	sitemapEventStream = "text/event-stream"
+)
+
+// GetSitemaps returns the sitemaps defined in openHAB
+func (c *Client) GetSitemaps() ([]api.Sitemap, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetSitemapsContext(ctx)
+}
+
+// GetSitemapsContext returns the sitemaps defined in openHAB
+func (c *Client) GetSitemapsContext(ctx context.Context) ([]api.Sitemap, error) {
+	sitemaps := make([]api.Sitemap, 0)
+	err := c.getJSON(ctx, "sitemaps", &sitemaps)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load sitemaps: %w", err)
+	}
+	return sitemaps, nil
+}
+
+// GetSitemapPage returns a page of the sitemap with its widgets.
+// An empty pageID returns the homepage of the sitemap.
+func (c *Client) GetSitemapPage(sitemapName, pageID string) (api.SitemapPage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.TimeoutHTTP)
+	defer cancel()
+	return c.GetSitemapPageContext(ctx, sitemapName, pageID)
+}
+
+// GetSitemapPageContext returns a page of the sitemap with its widgets.
+// An empty pageID returns the homepage of the sitemap.
+func (c *Client) GetSitemapPageContext(ctx context.Context, sitemapName, pageID string) (api.SitemapPage, error) {
+	if pageID == "" {
+		pageID = sitemapName
+	}
+	page := api.SitemapPage{}
+	err := c.getJSON(ctx, sitemapsPath+url.PathEscape(sitemapName)+"/"+url.PathEscape(pageID), &page)
+	if err != nil {
+		return api.SitemapPage{}, fmt.Errorf("cannot load page %q of sitemap %q: %w", pageID, sitemapName, err)
+	}
+	return page, nil
+}
+
+// SubscribeSitemapEvents subscribes to the events of a sitemap page (or the homepage if pageID is empty).
+// The events are sent to the rules like any other openHAB event: as event.SitemapWidgetUpdated and event.SitemapChanged.
+//
+// The method blocks until the context is cancelled (returning the context error),
+// or until the connection to openHAB is lost.
+func (c *Client) SubscribeSitemapEvents(ctx context.Context, sitemapName, pageID string) error {
+	if pageID == "" {
+		pageID = sitemapName
+	}
+	subscriptionID, err := c.subscribeSitemap(ctx)
+	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	query.Set("sitemap", sitemapName)
+	query.Set("pageid", pageID)
+	resp, err := c.get(ctx, sitemapEventsPath+url.PathEscape(subscriptionID)+"?"+query.Encode(), sitemapEventStream)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("cannot subscribe to events of sitemap %q: %w", sitemapName, err)
+	}
+	err = readEventStream(resp.Body, c.dispatchSitemapEvent)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// subscribeSitemap creates a new subscription to sitemap events and returns its ID
+func (c *Client) subscribeSitemap(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+sitemapSubscribe, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(c.user, c.password)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("cannot subscribe to sitemap events: %w", err)
+	}
+	defer resp.Body.Close()
+
+	err = statusError(resp)
+	if err != nil {
+		return "", fmt.Errorf("cannot subscribe to sitemap events: %w", err)
+	}
+	location := resp.Header.Get("Location")
+	if location == "" {
+		// the location is also sent in the response body
+		subscription := api.SitemapSubscription{}
+		err = json.NewDecoder(resp.Body).Decode(&subscription)
+		if err == nil && len(subscription.Context.Headers.Location) > 0 {
+			location = subscription.Context.Headers.Location[0]
+		}
+	}
+	if location == "" {
+		return "", errors.New("cannot subscribe to sitemap events: missing subscription location")
+	}
+	return path.Base(location), nil
+}
+
+func (c *Client) dispatchSitemapEvent(data string) {
+	e, err := event.NewSitemapEvent(data)
+	if err != nil {
+		errorlog.Printf("sitemap event ignored: %s", err)
+		return
+	}
+	if e.Type() == event.TypeServerAlive {
+		// keep-alive of the subscription: the server alive events are coming from the main event stream
+		return
+	}
+	c.systemEventBus.Publish(e)
+	c.userEventBus.Publish(e)
+}
@@ -0,0 +1,171 @@
+package openhab
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSitemaps(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t)
+	defer server.Close()
+	require.NoError(t, server.SetSitemap(api.Sitemap{Name: "home", Label: "My home"}))
+	require.NoError(t, server.SetSitemap(api.Sitemap{Name: "garden", Label: "Garden"}))
+
+	client := NewClient(Config{URL: server.URL()})
+	sitemaps, err := client.GetSitemaps()
+	require.NoError(t, err)
+	require.Len(t, sitemaps, 2)
+	assert.Equal(t, "garden", sitemaps[0].Name)
+	assert.Equal(t, "home", sitemaps[1].Name)
+	assert.Equal(t, "My home", sitemaps[1].Label)
+
+	assert.NoError(t, server.SitemapsErr())
+}
+
+func TestGetSitemapPage(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t)
+	defer server.Close()
+	homePage := api.SitemapPage{
+		ID:    "home",
+		Title: "Home",
+		Widgets: []api.SitemapWidget{
+			{
+				WidgetID:   "00",
+				Type:       "Switch",
+				Visibility: true,
+				Label:      "Light [ON]",
+				Icon:       "light",
+				State:      "ON",
+				Item:       &api.Item{Name: "Light", Type: "Switch", State: "ON"},
+			},
+			{
+				WidgetID:   "01",
+				Type:       "Text",
+				Visibility: true,
+				Label:      "Kitchen",
+				LinkedPage: &api.SitemapPage{ID: "01", Title: "Kitchen"},
+			},
+		},
+	}
+	kitchenPage := api.SitemapPage{
+		ID:    "01",
+		Title: "Kitchen",
+		Widgets: []api.SitemapWidget{
+			{WidgetID: "0100", Type: "Text", Label: "Temperature [21 °C]", Item: &api.Item{Name: "Temperature", Type: "Number:Temperature"}},
+		},
+	}
+	require.NoError(t, server.SetSitemap(api.Sitemap{Name: "home", Label: "My home", Homepage: &api.SitemapPage{ID: "home"}}, homePage, kitchenPage))
+	require.NoError(t, server.SetSitemap(api.Sitemap{Name: "garden", Label: "Garden"}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	homepage, err := client.GetSitemapPage("home", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Home", homepage.Title)
+	require.Len(t, homepage.Widgets, 2)
+	assert.Equal(t, "Light [ON]", homepage.Widgets[0].Label)
+	assert.Equal(t, "light", homepage.Widgets[0].Icon)
+	assert.True(t, homepage.Widgets[0].Visibility)
+	require.NotNil(t, homepage.Widgets[0].Item)
+	assert.Equal(t, "Light", homepage.Widgets[0].Item.Name)
+	require.NotNil(t, homepage.Widgets[1].LinkedPage)
+
+	kitchen, err := client.GetSitemapPage("home", homepage.Widgets[1].LinkedPage.ID)
+	require.NoError(t, err)
+	require.Len(t, kitchen.Widgets, 1)
+	assert.False(t, kitchen.Widgets[0].Visibility)
+	assert.Equal(t, "Temperature", kitchen.Widgets[0].Item.Name)
+
+	_, err = client.GetSitemapPage("garden", "")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.NoError(t, server.SitemapsErr())
+}
+
+func TestSubscribeSitemapEvents(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t)
+	defer server.Close()
+	require.NoError(t, server.SetSitemap(api.Sitemap{Name: "home", Homepage: &api.SitemapPage{ID: "home"}}, api.SitemapPage{ID: "home", Title: "Home"}))
+
+	var widget, changed atomic.Value
+	client := NewClient(Config{URL: server.URL()})
+	client.AddRule(RuleData{Name: "widget"}, func(ctx context.Context, client *Client, ruleData RuleData, e event.Event) {
+		if ev, ok := e.(event.SitemapWidgetUpdated); ok {
+			widget.Store(ev.Widget)
+		}
+	}, OnSitemapWidgetUpdated("home"))
+	client.AddRule(RuleData{Name: "changed"}, func(ctx context.Context, client *Client, ruleData RuleData, e event.Event) {
+		if ev, ok := e.(event.SitemapChanged); ok {
+			changed.Store(ev.PageID)
+		}
+	}, OnSitemapChanged("home"))
+	client.activateRules()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- client.SubscribeSitemapEvents(ctx, "home", "")
+	}()
+
+	// the events are lost until the client is subscribed
+	assert.Eventually(t, func() bool {
+		require.NoError(t, server.SitemapEvent(api.SitemapEvent{
+			SitemapName: "home",
+			PageID:      "home",
+			WidgetID:    "00",
+			Label:       "Light [OFF]",
+			Visibility:  true,
+			State:       "OFF",
+			Item:        &api.Item{Name: "Light", Type: "Switch", State: "OFF"},
+		}))
+		return widget.Load() != nil
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, event.SitemapWidget{
+		WidgetID:   "00",
+		Label:      "Light [OFF]",
+		Visibility: true,
+		State:      "OFF",
+		ItemName:   "Light",
+	}, widget.Load())
+
+	// not subscribed to this page
+	require.NoError(t, server.SitemapEvent(api.SitemapEvent{Type: api.SitemapEventChanged, SitemapName: "home", PageID: "01"}))
+	require.NoError(t, server.SitemapEvent(api.SitemapEvent{Type: api.SitemapEventAlive, SitemapName: "home", PageID: "home"}))
+	require.NoError(t, server.SitemapEvent(api.SitemapEvent{Type: api.SitemapEventChanged, SitemapName: "home", PageID: "home"}))
+	assert.Eventually(t, func() bool {
+		return changed.Load() != nil
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "home", changed.Load())
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("subscription still running after the context was cancelled")
+	}
+
+	assert.NoError(t, server.SitemapsErr())
+}
+
+func TestSubscribeUnknownSitemapEvents(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t)
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL()})
+	err := client.SubscribeSitemapEvents(context.Background(), "", "")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
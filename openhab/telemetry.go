@@ -1,18 +1,27 @@
 package openhab
 
 const (
-	MetricItemName         = "item_name"
-	MetricRuleID           = "rule_id"
-	MetricItemCacheHit     = "item.cache_hit"
-	MetricItemLoad         = "item.load"
-	MetricItemLoadState    = "item.load_state"
-	MetricItemSetState     = "item.set_state"
-	MetricItemNotFound     = "item.not_found"
-	MetricItemStateUpdated = "item.state_updated"
-	MetricItemsCacheSize   = "items.cache_size"
-	MetricRuleAdded        = "rule.added"
-	MetricRuleDeleted      = "rule.deleted"
-	MetricRulesCount       = "rules.count"
+	MetricItemName           = "item_name"
+	MetricRuleID             = "rule_id"
+	MetricThingUID           = "thing_uid"
+	MetricItemCacheHit       = "item.cache_hit"
+	MetricItemLoad           = "item.load"
+	MetricItemLoadState      = "item.load_state"
+	MetricItemSetState       = "item.set_state"
+	MetricItemPostUpdate     = "item.post_update"
+	MetricItemNotFound       = "item.not_found"
+	MetricItemStateUpdated   = "item.state_updated"
+	MetricItemsCacheSize     = "items.cache_size"
+	MetricItemHistorySize    = "item.history_size"
+	MetricThingActionInvoked = "thing.action_invoked"
+	MetricThingCacheHit      = "thing.cache_hit"
+	MetricThingLoad          = "thing.load"
+	MetricThingNotFound      = "thing.not_found"
+	MetricThingUpdated       = "thing.updated"
+	MetricThingsCacheSize    = "things.cache_size"
+	MetricRuleAdded          = "rule.added"
+	MetricRuleDeleted        = "rule.deleted"
+	MetricRulesCount         = "rules.count"
 )
 
 // MetricType is the type of metric
@@ -36,9 +45,17 @@ var metrics = []Metric{
 	{MetricItemLoad, "item load", MetricTypeCounter, []string{MetricItemName}},
 	{MetricItemLoadState, "item load state", MetricTypeCounter, []string{MetricItemName}},
 	{MetricItemSetState, "item set state", MetricTypeCounter, []string{MetricItemName}},
+	{MetricItemPostUpdate, "item post update", MetricTypeCounter, []string{MetricItemName}},
 	{MetricItemNotFound, "item not found", MetricTypeCounter, []string{MetricItemName}},
 	{MetricItemStateUpdated, "item state updated", MetricTypeCounter, []string{MetricItemName}},
 	{MetricItemsCacheSize, "items cache size", MetricTypeGauge, nil},
+	{MetricItemHistorySize, "item history size", MetricTypeGauge, []string{MetricItemName}},
+	{MetricThingActionInvoked, "thing action invoked", MetricTypeCounter, []string{MetricThingUID}},
+	{MetricThingCacheHit, "thing cache hit", MetricTypeCounter, []string{MetricThingUID}},
+	{MetricThingLoad, "thing load", MetricTypeCounter, []string{MetricThingUID}},
+	{MetricThingNotFound, "thing not found", MetricTypeCounter, []string{MetricThingUID}},
+	{MetricThingUpdated, "thing updated", MetricTypeCounter, []string{MetricThingUID}},
+	{MetricThingsCacheSize, "things cache size", MetricTypeGauge, nil},
 	{MetricRuleAdded, "rule added", MetricTypeCounter, []string{MetricRuleID}},
 	{MetricRuleDeleted, "rule deleted", MetricTypeCounter, []string{MetricRuleID}},
 	{MetricRulesCount, "rules count", MetricTypeGauge, nil},
@@ -1,15 +1,265 @@
 package openhab
 
-// Unused for now
-// type Thing struct {
-// 	uid    string
-// 	data   api.Thing
-// 	client *Client
-// }
-
-// func newThing(client *Client, uid string) *Thing {
-// 	return &Thing{
-// 		uid:    uid,
-// 		client: client,
-// 	}
-// }
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+)
+
+const (
+	thingsPath = "things/"
+)
+
+// Thing represents a thing in openHAB
+type Thing struct {
+	uid        string
+	data       api.Thing
+	client     *Client
+	dataLocker sync.Mutex
+	updated    time.Time
+}
+
+func newThing(client *Client, uid string) *Thing {
+	return &Thing{
+		uid:    uid,
+		client: client,
+	}
+}
+
+func (t *Thing) set(data api.Thing) *Thing {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	t.data = data
+	t.updated = time.Now()
+	return t
+}
+
+// load fetches the thing data from openHAB. The provided context controls the request
+// timeout and cancellation.
+func (t *Thing) load(ctx context.Context) error {
+	data := api.Thing{}
+
+	t.client.addCounter(MetricThingLoad, 1, MetricThingUID, t.uid)
+	err := t.client.getJSON(ctx, thingsPath+url.PathEscape(t.uid), &data)
+	if err != nil {
+		return err
+	}
+	t.set(data)
+	return nil
+}
+
+// setStatus updates the status information from a thing status event.
+// It does not trigger an API call.
+func (t *Thing) setStatus(status event.ThingStatus) {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	t.data.StatusInfo = api.ThingStatusInfo{
+		Status:       status.Status,
+		StatusDetail: status.StatusDetail,
+		Description:  status.Description,
+	}
+	t.updated = time.Now()
+}
+
+// setFromEvent updates the thing definition from a thing registry event.
+// The status information is kept as it is not sent with the event.
+func (t *Thing) setFromEvent(thing event.Thing) {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	t.data.Label = thing.Label
+	t.data.BridgeUID = thing.BridgeUID
+	t.data.Configuration = thing.Configuration
+	t.data.Properties = thing.Properties
+	t.data.ThingTypeUID = thing.ThingTypeUID
+	t.updated = time.Now()
+}
+
+// UID returns the unique identifier of the thing
+func (t *Thing) UID() string {
+	return t.uid
+}
+
+// Label returns the label of the thing
+func (t *Thing) Label() string {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return t.data.Label
+}
+
+// ThingTypeUID returns the type of the thing (like "zwave:device")
+func (t *Thing) ThingTypeUID() string {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return t.data.ThingTypeUID
+}
+
+// BridgeUID returns the UID of the bridge the thing is attached to,
+// or an empty string if the thing is not attached to a bridge
+func (t *Thing) BridgeUID() string {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return t.data.BridgeUID
+}
+
+// Status returns the last known status of the thing.
+//
+// Status is automatically refreshed from openHAB events.
+func (t *Thing) Status() ThingStatus {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return ThingStatus(t.data.StatusInfo.Status)
+}
+
+// StatusDetail returns the last known status detail of the thing.
+//
+// StatusDetail is automatically refreshed from openHAB events.
+func (t *Thing) StatusDetail() ThingStatusDetail {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return ThingStatusDetail(t.data.StatusInfo.StatusDetail)
+}
+
+// StatusDescription returns the last known description of the status (typically an error message).
+//
+// StatusDescription is automatically refreshed from openHAB events.
+func (t *Thing) StatusDescription() string {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return t.data.StatusInfo.Description
+}
+
+// Properties returns a copy of the properties of the thing
+func (t *Thing) Properties() map[string]string {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return maps.Clone(t.data.Properties)
+}
+
+// Configuration returns a copy of the configuration of the thing
+func (t *Thing) Configuration() map[string]any {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return maps.Clone(t.data.Configuration)
+}
+
+// Updated returns the last time the thing definition or status was updated
+func (t *Thing) Updated() time.Time {
+	t.dataLocker.Lock()
+	defer t.dataLocker.Unlock()
+
+	return t.updated
+}
+
+// IsEnabled returns false if the thing was explicitly disabled
+func (t *Thing) IsEnabled() bool {
+	return t.StatusDetail() != ThingStatusDetailDisabled
+}
+
+// Enable a thing that was previously disabled
+func (t *Thing) Enable() error {
+	ctx, cancel := context.WithTimeout(context.Background(), t.client.config.TimeoutHTTP)
+	defer cancel()
+
+	return t.EnableContext(ctx)
+}
+
+// EnableContext enables a thing that was previously disabled
+func (t *Thing) EnableContext(ctx context.Context) error {
+	return t.setEnabled(ctx, true)
+}
+
+// Disable a thing: the thing handler is stopped and its status becomes UNINITIALIZED (DISABLED)
+func (t *Thing) Disable() error {
+	ctx, cancel := context.WithTimeout(context.Background(), t.client.config.TimeoutHTTP)
+	defer cancel()
+
+	return t.DisableContext(ctx)
+}
+
+// DisableContext disables a thing: the thing handler is stopped and its status becomes UNINITIALIZED (DISABLED)
+func (t *Thing) DisableContext(ctx context.Context) error {
+	return t.setEnabled(ctx, false)
+}
+
+func (t *Thing) setEnabled(ctx context.Context, enabled bool) error {
+	data := api.Thing{}
+	err := t.client.putString(ctx, thingsPath+url.PathEscape(t.uid)+"/enable", strconv.FormatBool(enabled), &data)
+	if err != nil {
+		return fmt.Errorf("cannot set enabled=%t on thing %q: %w", enabled, t.uid, err)
+	}
+	t.set(data)
+	return nil
+}
+
+// UpdateConfiguration sends the new configuration parameters of the thing.
+// The parameters are merged into the existing configuration.
+//
+// It returns ErrConflict if the thing is not editable (like a thing defined in a file),
+// or ErrBadRequest if the configuration is not valid.
+func (t *Thing) UpdateConfiguration(configuration map[string]any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), t.client.config.TimeoutHTTP)
+	defer cancel()
+
+	return t.UpdateConfigurationContext(ctx, configuration)
+}
+
+// UpdateConfigurationContext sends the new configuration parameters of the thing.
+// The parameters are merged into the existing configuration.
+//
+// It returns ErrConflict if the thing is not editable (like a thing defined in a file),
+// or ErrBadRequest if the configuration is not valid.
+func (t *Thing) UpdateConfigurationContext(ctx context.Context, configuration map[string]any) error {
+	data := api.Thing{}
+	err := t.client.putJSON(ctx, thingsPath+url.PathEscape(t.uid)+"/config", configuration, &data)
+	if err != nil {
+		return fmt.Errorf("cannot update configuration of thing %q: %w", t.uid, err)
+	}
+	t.set(data)
+	return nil
+}
+
+// SetLabel changes the label of the thing.
+//
+// It returns ErrConflict if the thing is not editable (like a thing defined in a file).
+func (t *Thing) SetLabel(label string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), t.client.config.TimeoutHTTP)
+	defer cancel()
+
+	return t.SetLabelContext(ctx, label)
+}
+
+// SetLabelContext changes the label of the thing.
+//
+// It returns ErrConflict if the thing is not editable (like a thing defined in a file).
+func (t *Thing) SetLabelContext(ctx context.Context, label string) error {
+	data := api.Thing{}
+	// openHAB only updates the fields sent in the request
+	update := map[string]any{
+		"UID":   t.uid,
+		"label": label,
+	}
+	err := t.client.putJSON(ctx, thingsPath+url.PathEscape(t.uid), update, &data)
+	if err != nil {
+		return fmt.Errorf("cannot update label of thing %q: %w", t.uid, err)
+	}
+	t.set(data)
+	return nil
+}
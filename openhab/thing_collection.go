@@ -0,0 +1,121 @@
+package openhab
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+// thingCollection represents the collection of things in openHAB
+type thingCollection struct {
+	client      *Client
+	cache       map[string]*Thing
+	cacheLocker sync.Mutex
+}
+
+func newThings(client *Client) *thingCollection {
+	return &thingCollection{
+		client: client,
+		cache:  nil,
+	}
+}
+
+// getThing returns an openHAB thing from its UID.
+// The very first call will try to load the things collection from openHAB.
+func (things *thingCollection) getThing(ctx context.Context, uid string) (*Thing, error) {
+	things.cacheLocker.Lock()
+	defer things.cacheLocker.Unlock()
+
+	if things.cache == nil {
+		// load them all now
+		err := things.loadCache(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// try to get the thing from the cache
+	if thing, ok := things.cache[uid]; ok {
+		things.client.addCounter(MetricThingCacheHit, 1, MetricThingUID, uid)
+		return thing, nil
+	}
+	// try to call the API to get the thing
+	thing := newThing(things.client, uid)
+	if err := thing.load(ctx); err == nil {
+		things.cache[uid] = thing
+		return thing, nil
+	}
+	// thing wasn't found
+	things.client.addCounter(MetricThingNotFound, 1, MetricThingUID, uid)
+	return nil, fmt.Errorf("thing %q %w", uid, ErrNotFound)
+}
+
+// getThings returns all the things from the cache.
+// The very first call will try to load the things collection from openHAB.
+func (things *thingCollection) getThings(ctx context.Context) ([]*Thing, error) {
+	things.cacheLocker.Lock()
+	defer things.cacheLocker.Unlock()
+
+	if things.cache == nil {
+		// load them all now
+		err := things.loadCache(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	all := make([]*Thing, 0, len(things.cache))
+	for _, thing := range things.cache {
+		all = append(all, thing)
+	}
+	return all, nil
+}
+
+func (things *thingCollection) removeThing(uid string) {
+	things.cacheLocker.Lock()
+	defer things.cacheLocker.Unlock()
+
+	delete(things.cache, uid)
+}
+
+// refreshCache reloads the things from openHAB and updates the cache.
+// This method is thread safe.
+func (things *thingCollection) refreshCache(ctx context.Context) error {
+	things.cacheLocker.Lock()
+	defer things.cacheLocker.Unlock()
+
+	return things.loadCache(ctx)
+}
+
+// loadCache loads all things into the cache.
+// The things already in the cache are updated in place, so the instances held by the callers stay in sync.
+// This method is NOT using the cacheLocker: it is the responsibility of the caller to do so.
+func (things *thingCollection) loadCache(ctx context.Context) error {
+	all, err := things.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	cache := make(map[string]*Thing, len(all))
+	for _, data := range all {
+		thing, ok := things.cache[data.UID]
+		if !ok {
+			thing = newThing(things.client, data.UID)
+		}
+		cache[data.UID] = thing.set(data)
+	}
+	things.cache = cache
+	things.client.setGauge(MetricThingsCacheSize, int64(len(things.cache)), "", "")
+	return nil
+}
+
+// load all things from the API
+func (things *thingCollection) load(ctx context.Context) ([]api.Thing, error) {
+	all := make([]api.Thing, 0)
+	err := things.client.getJSON(ctx, "things", &all)
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
@@ -0,0 +1,233 @@
+package openhab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetThingAPI(t *testing.T) {
+	t.Parallel()
+	thing1 := api.Thing{
+		UID:   "zwave:device:c4dcc784:node8",
+		Label: "Z-Wave Node 8",
+		StatusInfo: api.ThingStatusInfo{
+			Status:       string(ThingStatusOnline),
+			StatusDetail: string(ThingStatusDetailNone),
+		},
+		BridgeUID:     "zwave:serial_zstick:c4dcc784",
+		Configuration: map[string]any{"node_id": float64(8)},
+		Properties:    map[string]string{"zwave_nodeid": "8"},
+		ThingTypeUID:  "zwave:device",
+	}
+
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetThing(thing1))
+
+	client := NewClient(Config{
+		URL: server.URL(),
+	})
+
+	t.Run("TestGetThingNotFound", func(t *testing.T) {
+		thing, err := client.GetThing("unknown:thing")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, thing)
+	})
+
+	t.Run("TestGetThing", func(t *testing.T) {
+		thing, err := client.GetThing(thing1.UID)
+		require.NoError(t, err)
+		assert.Equal(t, thing1.UID, thing.UID())
+		assert.Equal(t, thing1.Label, thing.Label())
+		assert.Equal(t, thing1.ThingTypeUID, thing.ThingTypeUID())
+		assert.Equal(t, thing1.BridgeUID, thing.BridgeUID())
+		assert.Equal(t, ThingStatusOnline, thing.Status())
+		assert.Equal(t, ThingStatusDetailNone, thing.StatusDetail())
+		assert.Equal(t, thing1.Properties, thing.Properties())
+		assert.Equal(t, thing1.Configuration, thing.Configuration())
+	})
+
+	t.Run("TestGetThings", func(t *testing.T) {
+		things, err := client.GetThings()
+		require.NoError(t, err)
+		require.Len(t, things, 1)
+		assert.Equal(t, thing1.UID, things[0].UID())
+	})
+
+	t.Run("TestRefreshThings", func(t *testing.T) {
+		thing, err := client.GetThing(thing1.UID)
+		require.NoError(t, err)
+
+		renamed := thing1
+		renamed.Label = "Z-Wave Node 8 renamed"
+		require.NoError(t, server.SetThing(renamed))
+		require.NoError(t, client.RefreshCache())
+
+		// the instance is updated in place
+		reloaded, err := client.GetThing(thing1.UID)
+		require.NoError(t, err)
+		assert.Same(t, thing, reloaded)
+		assert.Equal(t, renamed.Label, thing.Label())
+	})
+
+	assert.NoError(t, server.EventsErr())
+	assert.NoError(t, server.ThingsErr())
+}
+
+func TestThingCacheFollowsEvents(t *testing.T) {
+	t.Parallel()
+	const thingUID = "mqtt:homie300:6a75cc6119:test"
+
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetThing(api.Thing{
+		UID:          thingUID,
+		Label:        "Test",
+		StatusInfo:   api.ThingStatusInfo{Status: string(ThingStatusOnline), StatusDetail: string(ThingStatusDetailNone)},
+		ThingTypeUID: "mqtt:homie300",
+	}))
+
+	client := NewClient(Config{URL: server.URL()})
+	client.addInternalRules()
+
+	thing, err := client.GetThingContext(context.Background(), thingUID)
+	require.NoError(t, err)
+	assert.Equal(t, ThingStatusOnline, thing.Status())
+
+	client.systemEventBus.Publish(event.NewThingStatusInfoEvent(thingUID, event.ThingStatus{
+		Status:       string(ThingStatusOffline),
+		StatusDetail: string(ThingStatusDetailCommunicationError),
+		Description:  "Did not receive all required topics",
+	}))
+	assert.Equal(t, ThingStatusOffline, thing.Status())
+	assert.Equal(t, ThingStatusDetailCommunicationError, thing.StatusDetail())
+	assert.Equal(t, "Did not receive all required topics", thing.StatusDescription())
+
+	client.systemEventBus.Publish(event.NewThingStatusInfoChangedEvent(thingUID,
+		event.ThingStatus{Status: string(ThingStatusOffline)},
+		event.ThingStatus{Status: string(ThingStatusOnline), StatusDetail: string(ThingStatusDetailNone)},
+	))
+	assert.Equal(t, ThingStatusOnline, thing.Status())
+	assert.Equal(t, ThingStatusDetailNone, thing.StatusDetail())
+
+	client.systemEventBus.Publish(event.NewThingUpdated(
+		event.Thing{UID: thingUID, Label: "Test", ThingTypeUID: "mqtt:homie300"},
+		event.Thing{UID: thingUID, Label: "Renamed", ThingTypeUID: "mqtt:homie300", Properties: map[string]string{"homieversion": "4.0.0"}},
+	))
+	assert.Equal(t, "Renamed", thing.Label())
+	assert.Equal(t, map[string]string{"homieversion": "4.0.0"}, thing.Properties())
+	// status is not sent with the updated event
+	assert.Equal(t, ThingStatusOnline, thing.Status())
+
+	// removed from the cache: the next call loads a new instance from the API
+	client.systemEventBus.Publish(event.NewThingRemoved(event.Thing{UID: thingUID}))
+	reloaded, err := client.GetThingContext(context.Background(), thingUID)
+	require.NoError(t, err)
+	assert.NotSame(t, thing, reloaded)
+
+	assert.NoError(t, server.ThingsErr())
+}
+
+func TestRefreshThingsNotLoaded(t *testing.T) {
+	t.Parallel()
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+	require.NoError(t, server.SetThing(api.Thing{UID: "astro:sun:local", Label: "Sun"}))
+
+	client := NewClient(Config{URL: server.URL()})
+	// the things are loaded even if the cache was never used
+	require.NoError(t, client.RefreshCache())
+	assert.NotNil(t, client.things.cache)
+	assert.Contains(t, client.things.cache, "astro:sun:local")
+
+	assert.NoError(t, server.ThingsErr())
+}
+
+func TestUpdateThing(t *testing.T) {
+	t.Parallel()
+	const thingUID = "astro:sun:local"
+
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetThing(api.Thing{
+		UID:           thingUID,
+		Label:         "Sun",
+		StatusInfo:    api.ThingStatusInfo{Status: string(ThingStatusOnline), StatusDetail: string(ThingStatusDetailNone)},
+		Configuration: map[string]any{"interval": float64(300)},
+		ThingTypeUID:  "astro:sun",
+	}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	thing, err := client.GetThing(thingUID)
+	require.NoError(t, err)
+	assert.True(t, thing.IsEnabled())
+
+	t.Run("TestDisableEnable", func(t *testing.T) {
+		require.NoError(t, thing.Disable())
+		assert.False(t, thing.IsEnabled())
+		assert.Equal(t, ThingStatusUninitialized, thing.Status())
+
+		require.NoError(t, thing.Enable())
+		assert.True(t, thing.IsEnabled())
+		assert.Equal(t, ThingStatusOnline, thing.Status())
+	})
+
+	t.Run("TestUpdateConfiguration", func(t *testing.T) {
+		require.NoError(t, thing.UpdateConfiguration(map[string]any{"geolocation": "51.5,-0.1"}))
+		assert.Equal(t, map[string]any{"interval": float64(300), "geolocation": "51.5,-0.1"}, thing.Configuration())
+	})
+
+	t.Run("TestSetLabel", func(t *testing.T) {
+		require.NoError(t, thing.SetLabel("Local Sun"))
+		assert.Equal(t, "Local Sun", thing.Label())
+	})
+
+	t.Run("TestUpdateThingNotFound", func(t *testing.T) {
+		unknown := newThing(client, "unknown:thing")
+		assert.ErrorIs(t, unknown.Enable(), ErrNotFound)
+		assert.ErrorIs(t, unknown.SetLabel("label"), ErrNotFound)
+	})
+
+	assert.NoError(t, server.ThingsErr())
+}
+
+func TestUpdateThingErrors(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		status   int
+		expected error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusBadRequest, ErrBadRequest},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(http.StatusText(testCase.status), func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(testCase.status)
+			}))
+			defer server.Close()
+
+			client := NewClient(Config{URL: server.URL})
+			thing := newThing(client, "astro:sun:local")
+
+			assert.ErrorIs(t, thing.Disable(), testCase.expected)
+			assert.ErrorIs(t, thing.UpdateConfiguration(map[string]any{"interval": "invalid"}), testCase.expected)
+			assert.ErrorIs(t, thing.SetLabel("Sun"), testCase.expected)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package openhab
 
 import (
+	"fmt"
+
 	"github.com/creativeprojects/gopenhab/event"
 	"github.com/robfig/cron/v3"
 )
@@ -9,10 +11,15 @@ import (
 type subscriber interface {
 	subscribe(name string, eventType event.Type, callback func(e event.Event)) int
 	unsubscribe(subID int)
+	isMemberOf(itemName, groupName string) bool
 	getCron() *cron.Cron
 }
 
-// Trigger is a generic interface for catching incoming messages on the event bus
+// Trigger is a generic interface for catching incoming messages on the event bus.
+//
+// The name of the item, thing or channel given to a trigger can also be a glob pattern
+// (like "Temperature_*") or a regular expression surrounded by slashes (like "/^Temperature_(Kitchen|Lounge)$/").
+// See event.Pattern for more information. A malformed pattern is reported as an error when the rule is activated.
 type Trigger interface {
 	// activate the trigger for func() in the context of a *Client (via subscriber interface)
 	activate(client subscriber, run func(ev event.Event), ruleData RuleData) error
@@ -41,3 +48,13 @@ func (t baseTrigger) subscribe(
 		}
 	})
 }
+
+// compilePattern returns the pattern of the name of an item, a thing or a channel,
+// or an error if the glob pattern or the regular expression is malformed
+func compilePattern(name string) (event.Pattern, error) {
+	pattern, err := event.CompilePattern(name)
+	if err != nil {
+		return pattern, fmt.Errorf("invalid pattern %q: %w", name, err)
+	}
+	return pattern, nil
+}
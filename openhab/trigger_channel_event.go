@@ -0,0 +1,59 @@
+package openhab
+
+import "github.com/creativeprojects/gopenhab/event"
+
+type channelTriggeredTrigger struct {
+	baseTrigger
+	channel string
+	event   string
+	subID   int
+}
+
+// OnChannelTriggered triggers the rule when the trigger channel fires an event equal to ev
+// (like SHORT_PRESSED, LONG_PRESSED or START).
+// Pass an empty string to ev to receive any event from the channel.
+// This is an equivalent of the DSL rule:
+//
+// Channel "<triggerChannel>" triggered [<triggerEvent>]
+func OnChannelTriggered(channel, ev string) *channelTriggeredTrigger {
+	return &channelTriggeredTrigger{
+		channel: channel,
+		event:   ev,
+	}
+}
+
+func (c *channelTriggeredTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	if _, err := compilePattern(c.channel); err != nil {
+		return err
+	}
+	c.subID = c.subscribe(client, c.channel, event.TypeChannelTriggered, run, c.match)
+	return nil
+}
+
+func (c *channelTriggeredTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *channelTriggeredTrigger) match(e event.Event) bool {
+	if c.event != "" {
+		// check for the desired event
+		if ev, ok := e.(event.ChannelTriggered); ok {
+			if ev.Event != c.event {
+				// not the event we wanted
+				return false
+			}
+		} else {
+			panic("expected event of type event.ChannelTriggered")
+		}
+	}
+	return true
+}
+
+// Interface
+var _ Trigger = &channelTriggeredTrigger{}
@@ -0,0 +1,71 @@
+package openhab
+
+import (
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+func TestMatchingChannelEvent(t *testing.T) {
+	t.Parallel()
+	testEvents := []struct {
+		e       event.Event
+		trigger Trigger
+		match   bool
+	}{
+		{
+			event.NewChannelTriggered("deconz:switch:1:button", "1002"),
+			OnChannelTriggered("deconz:switch:1:button", ""),
+			true,
+		},
+		{
+			event.NewChannelTriggered("deconz:switch:1:button", "SHORT_PRESSED"),
+			OnChannelTriggered("deconz:switch:1:button", "SHORT_PRESSED"),
+			true,
+		},
+		{
+			event.NewChannelTriggered("deconz:switch:1:button", "LONG_PRESSED"),
+			OnChannelTriggered("deconz:switch:1:button", "SHORT_PRESSED"),
+			false,
+		},
+	}
+
+	for _, testEvent := range testEvents {
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testEvent.match, testEvent.trigger.match(testEvent.e))
+		})
+	}
+}
+
+func TestChannelEventSubscription(t *testing.T) {
+	t.Parallel()
+	const subID = 13
+	const channel = "astro:sun:local:set#event"
+
+	calls := 0
+	run := func(ev event.Event) {
+		calls++
+	}
+
+	var subscribedCallback func(e event.Event)
+
+	client := newMockSubscriber(t)
+	client.On("subscribe", channel, event.TypeChannelTriggered, mock.Anything).
+		Return(func(name string, eventType event.Type, callback func(e event.Event)) int {
+			subscribedCallback = callback
+			return subID
+		})
+
+	trigger := OnChannelTriggered(channel, "START")
+	err := trigger.activate(client, run, RuleData{})
+	assert.NoError(t, err)
+	assert.NotNil(t, subscribedCallback)
+
+	subscribedCallback(event.NewChannelTriggered(channel, "END"))
+	assert.Equal(t, 0, calls)
+	subscribedCallback(event.NewChannelTriggered(channel, "START"))
+	assert.Equal(t, 1, calls)
+}
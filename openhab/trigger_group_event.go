@@ -0,0 +1,46 @@
+package openhab
+
+import "github.com/creativeprojects/gopenhab/event"
+
+type groupStateUpdatedTrigger struct {
+	baseTrigger
+	group string
+	subID int
+}
+
+// OnGroupStateUpdated triggers the rule when the state of the group has been updated through one of its members.
+// The rule receives an event.GroupItemStateUpdated where TriggeringItem is the name of the member.
+// Pass an empty string to group to receive the updates from all the groups.
+func OnGroupStateUpdated(group string) *groupStateUpdatedTrigger {
+	return &groupStateUpdatedTrigger{
+		group: group,
+	}
+}
+
+func (c *groupStateUpdatedTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	if _, err := compilePattern(c.group); err != nil {
+		return err
+	}
+	c.subID = c.subscribe(client, c.group, event.TypeGroupItemStateUpdated, run, c.match)
+	return nil
+}
+
+func (c *groupStateUpdatedTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *groupStateUpdatedTrigger) match(e event.Event) bool {
+	if _, ok := e.(event.GroupItemStateUpdated); !ok {
+		panic("expected event of type event.GroupItemStateUpdated")
+	}
+	return true
+}
+
+// Interface
+var _ Trigger = &groupStateUpdatedTrigger{}
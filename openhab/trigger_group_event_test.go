@@ -0,0 +1,93 @@
+package openhab
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupStateUpdatedSubscription(t *testing.T) {
+	t.Parallel()
+	testData := []struct {
+		group string
+	}{
+		{"Heating"},
+		{"Heating_*"},
+		{""},
+	}
+
+	for _, testItem := range testData {
+		t.Run(testItem.group, func(t *testing.T) {
+			t.Parallel()
+			client := newMockSubscriber(t)
+			client.On("subscribe", testItem.group, event.TypeGroupItemStateUpdated, mock.Anything).Return(1).Once()
+			client.On("unsubscribe", 1).Once()
+
+			trigger := OnGroupStateUpdated(testItem.group)
+			err := trigger.activate(client, func(ev event.Event) {}, RuleData{})
+			require.NoError(t, err)
+			err = trigger.activate(client, func(ev event.Event) {}, RuleData{})
+			assert.ErrorIs(t, err, ErrRuleAlreadyActivated)
+
+			assert.True(t, trigger.match(event.NewGroupItemStateUpdated("Heating", "Heating_Kitchen", "Decimal", "3")))
+			trigger.deactivate(client)
+		})
+	}
+}
+
+func TestGroupStateUpdatedRule(t *testing.T) {
+	t.Parallel()
+	wg := sync.WaitGroup{}
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	require.NoError(t, server.SetItem(api.Item{Name: "Heating", Type: "Group", GroupType: "Number", State: "0"}))
+
+	client := NewClient(Config{URL: server.URL()})
+
+	calls := make(chan event.GroupItemStateUpdated, 2)
+	client.AddRule(RuleData{Name: "heating demand"}, func(ctx context.Context, client *Client, ruleData RuleData, e event.Event) {
+		if ev, ok := e.(event.GroupItemStateUpdated); ok {
+			calls <- ev
+		}
+	}, OnGroupStateUpdated("Heating"))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		client.Start()
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	server.Event(event.NewGroupItemStateUpdated("Heating", "Heating_Kitchen", "Decimal", "3"))
+	// this one should not trigger the rule
+	server.Event(event.NewGroupItemStateUpdated("Lights", "Light_Kitchen", "OnOff", "ON"))
+
+	select {
+	case ev := <-calls:
+		assert.Equal(t, "Heating", ev.ItemName)
+		assert.Equal(t, "Heating_Kitchen", ev.TriggeringItem)
+		assert.Equal(t, "3", ev.State)
+	case <-time.After(time.Second):
+		t.Fatal("rule not triggered")
+	}
+
+	// the state of the group is kept in the cache
+	item, err := client.GetItem("Heating")
+	require.NoError(t, err)
+	assert.Equal(t, "3", item.getInternalState().String())
+
+	client.Stop()
+	wg.Wait()
+	server.Close()
+
+	assert.Empty(t, calls)
+	assert.NoError(t, server.EventsErr())
+	assert.NoError(t, server.ItemsErr())
+}
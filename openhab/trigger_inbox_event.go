@@ -0,0 +1,46 @@
+package openhab
+
+import "github.com/creativeprojects/gopenhab/event"
+
+type inboxAddedTrigger struct {
+	baseTrigger
+	thing string
+	subID int
+}
+
+// OnInboxAdded triggers the rule when a new discovery result is added to the inbox.
+// Pass an empty string to thing to be notified of any new discovery result.
+// The event received is of type event.InboxAdded.
+func OnInboxAdded(thing string) *inboxAddedTrigger {
+	return &inboxAddedTrigger{
+		thing: thing,
+	}
+}
+
+func (c *inboxAddedTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	if _, err := compilePattern(c.thing); err != nil {
+		return err
+	}
+	c.subID = c.subscribe(client, c.thing, event.TypeInboxAdded, run, c.match)
+	return nil
+}
+
+func (c *inboxAddedTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *inboxAddedTrigger) match(e event.Event) bool {
+	if _, ok := e.(event.InboxAdded); !ok {
+		panic("expected event of type event.InboxAdded")
+	}
+	return true
+}
+
+// Interface
+var _ Trigger = &inboxAddedTrigger{}
@@ -0,0 +1,44 @@
+package openhab
+
+import (
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+func TestInboxAddedSubscription(t *testing.T) {
+	t.Parallel()
+	const subID = 12
+
+	calls := 0
+	run := func(ev event.Event) {
+		calls++
+	}
+
+	var subscribedCallback func(e event.Event)
+
+	client := newMockSubscriber(t)
+	client.On("subscribe", "", event.TypeInboxAdded, mock.Anything).
+		Return(func(name string, eventType event.Type, callback func(e event.Event)) int {
+			subscribedCallback = callback
+			return subID
+		})
+	client.On("unsubscribe", subID).Return()
+
+	trigger := OnInboxAdded("")
+	err := trigger.activate(client, run, RuleData{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, trigger.activate(client, run, RuleData{}), ErrRuleAlreadyActivated)
+	assert.NotNil(t, subscribedCallback)
+
+	subscribedCallback(event.NewInboxAdded(event.DiscoveryResult{ThingUID: "hue:0220:1:5", ThingTypeUID: "hue:0220"}))
+	assert.Equal(t, 1, calls)
+
+	assert.Panics(t, func() {
+		trigger.match(event.NewInboxRemoved(event.DiscoveryResult{ThingUID: "hue:0220:1:5"}))
+	})
+
+	trigger.deactivate(client)
+}
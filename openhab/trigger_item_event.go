@@ -8,20 +8,21 @@ import (
 
 type itemReceivedCommandTrigger struct {
 	baseTrigger
-	item  string
-	state State
-	subID int
+	item    string
+	command Command
+	subID   int
 }
 
-// OnItemReceivedCommand triggers the rule when the item received a command equal to state.
-// Use a nil state to receive ANY command sent to the item
+// OnItemReceivedCommand triggers the rule when the item received a command equal to command.
+// The command can be a state (like SwitchON) or a command (like CommandINCREASE or CommandREFRESH).
+// Use a nil command to receive ANY command sent to the item
 // This is an equivalent of the DSL rule:
 //
 // Item <item> received command [<command>]
-func OnItemReceivedCommand(item string, state State) *itemReceivedCommandTrigger {
+func OnItemReceivedCommand(item string, command Command) *itemReceivedCommandTrigger {
 	return &itemReceivedCommandTrigger{
-		item:  item,
-		state: state,
+		item:    item,
+		command: command,
 	}
 }
 
@@ -29,6 +30,9 @@ func (c *itemReceivedCommandTrigger) activate(client subscriber, run func(ev eve
 	if c.subID > 0 {
 		return ErrRuleAlreadyActivated
 	}
+	if _, err := compilePattern(c.item); err != nil {
+		return err
+	}
 	c.subID = c.subscribe(client, c.item, event.TypeItemCommand, run, c.match)
 	return nil
 }
@@ -41,10 +45,10 @@ func (c *itemReceivedCommandTrigger) deactivate(client subscriber) {
 }
 
 func (c *itemReceivedCommandTrigger) match(e event.Event) bool {
-	if c.state != nil && c.state.String() != "" {
-		// check for the desired state
+	if c.command != nil && c.command.String() != "" {
+		// check for the desired command
 		if ev, ok := e.(event.ItemReceivedCommand); ok {
-			if ev.Command != c.state.String() {
+			if ev.Command != c.command.String() {
 				// not the value we wanted
 				return false
 			}
@@ -82,6 +86,9 @@ func (c *itemReceivedStateTrigger) activate(client subscriber, run func(ev event
 	if c.subID > 0 {
 		return ErrRuleAlreadyActivated
 	}
+	if _, err := compilePattern(c.item); err != nil {
+		return err
+	}
 	c.subID = c.subscribe(client, c.item, event.TypeItemState, run, c.match)
 	return nil
 }
@@ -114,11 +121,13 @@ var _ Trigger = &itemReceivedStateTrigger{}
 
 type itemStateChangedTrigger struct {
 	baseTrigger
-	item   string
-	from   State
-	to     State
-	subID1 int
-	subID2 int
+	item          string
+	from          State
+	to            State
+	fromUndefined bool
+	toUndefined   bool
+	subID1        int
+	subID2        int
 }
 
 // OnItemStateChanged triggers the rule when the item received an update with a different state
@@ -165,6 +174,34 @@ func OnItemStateChangedFromTo(item string, from, to State) *itemStateChangedTrig
 	}
 }
 
+// OnItemStateChangedFromUndefined triggers the rule when the item changed from NULL or UNDEF to any other state
+// (like when openHAB is starting, or when a binding goes back online).
+// This is an equivalent of the DSL rules:
+//
+// Item <item> changed from NULL
+//
+// Item <item> changed from UNDEF
+func OnItemStateChangedFromUndefined(item string) *itemStateChangedTrigger {
+	return &itemStateChangedTrigger{
+		item:          item,
+		fromUndefined: true,
+	}
+}
+
+// OnItemStateChangedToUndefined triggers the rule when the item changed to NULL or UNDEF
+// (like when a binding goes offline).
+// This is an equivalent of the DSL rules:
+//
+// Item <item> changed to NULL
+//
+// Item <item> changed to UNDEF
+func OnItemStateChangedToUndefined(item string) *itemStateChangedTrigger {
+	return &itemStateChangedTrigger{
+		item:        item,
+		toUndefined: true,
+	}
+}
+
 func (c *itemStateChangedTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
 	if run == nil {
 		return errors.New("event callback is nil")
@@ -172,6 +209,9 @@ func (c *itemStateChangedTrigger) activate(client subscriber, run func(ev event.
 	if c.subID1 > 0 || c.subID2 > 0 {
 		return ErrRuleAlreadyActivated
 	}
+	if _, err := compilePattern(c.item); err != nil {
+		return err
+	}
 	c.subID1 = c.subscribe(client, c.item, event.TypeItemStateChanged, run, c.match)
 	c.subID2 = c.subscribe(client, c.item, event.TypeGroupItemStateChanged, run, c.match)
 	return nil
@@ -189,6 +229,22 @@ func (c *itemStateChangedTrigger) deactivate(client subscriber) {
 }
 
 func (c *itemStateChangedTrigger) match(e event.Event) bool {
+	if c.fromUndefined || c.toUndefined {
+		var previousState, newState string
+		if ev, ok := e.(event.ItemStateChanged); ok {
+			previousState, newState = ev.PreviousState, ev.NewState
+		} else if ev, ok := e.(event.GroupItemStateChanged); ok {
+			previousState, newState = ev.PreviousState, ev.NewState
+		} else {
+			panic("expected event of type event.ItemStateChanged or event.GroupItemStateChanged")
+		}
+		if c.fromUndefined && (!isUndefinedValue(previousState) || isUndefinedValue(newState)) {
+			return false
+		}
+		if c.toUndefined && !isUndefinedValue(newState) {
+			return false
+		}
+	}
 	if c.from != nil && c.from.String() != "" {
 		// check for the desired state
 		if ev, ok := e.(event.ItemStateChanged); ok {
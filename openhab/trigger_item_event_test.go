@@ -1,6 +1,8 @@
 package openhab
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
 
 	"github.com/creativeprojects/gopenhab/event"
@@ -37,6 +39,57 @@ func TestMatchingItemEvent(t *testing.T) {
 			OnItemReceivedCommand("TestItem", nil),
 			true,
 		},
+		{
+			event.NewItemReceivedCommand("TestItem", "IncreaseDecrease", "INCREASE"),
+			OnItemReceivedCommand("TestItem", CommandINCREASE),
+			true,
+		},
+		{
+			event.NewItemReceivedCommand("TestItem", "IncreaseDecrease", "DECREASE"),
+			OnItemReceivedCommand("TestItem", CommandINCREASE),
+			false,
+		},
+		{
+			event.NewItemReceivedCommand("TestItem", "Refresh", "REFRESH"),
+			OnItemReceivedCommand("TestItem", CommandREFRESH),
+			true,
+		},
+		// changed from/to undefined
+		{
+			event.NewItemStateChanged("TestItem", "UnDef", "NULL", "Decimal", "20"),
+			OnItemStateChangedFromUndefined("TestItem"),
+			true,
+		},
+		{
+			event.NewGroupItemStateChanged("TestItem", "TriggeringItem", "UnDef", "UNDEF", "OnOff", "ON"),
+			OnItemStateChangedFromUndefined("TestItem"),
+			true,
+		},
+		{
+			event.NewItemStateChanged("TestItem", "UnDef", "NULL", "UnDef", "UNDEF"),
+			OnItemStateChangedFromUndefined("TestItem"),
+			false,
+		},
+		{
+			event.NewItemStateChanged("TestItem", "Decimal", "19", "Decimal", "20"),
+			OnItemStateChangedFromUndefined("TestItem"),
+			false,
+		},
+		{
+			event.NewItemStateChanged("TestItem", "Decimal", "20", "UnDef", "UNDEF"),
+			OnItemStateChangedToUndefined("TestItem"),
+			true,
+		},
+		{
+			event.NewItemStateChanged("TestItem", "UnDef", "UNDEF", "Decimal", "20"),
+			OnItemStateChangedToUndefined("TestItem"),
+			false,
+		},
+		{
+			event.NewItemStateChanged("TestItem", "Decimal", "20", "UnDef", "NULL"),
+			OnItemStateChangedTo("TestItem", UnDefNULL),
+			true,
+		},
 		// received state
 		{
 			event.NewItemReceivedState("TestItem", "OnOff", "ON"),
@@ -250,3 +303,20 @@ func TestItemEventSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestItemEventWithPattern(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	client := NewClient(Config{URL: "http://localhost"})
+	client.AddRule(RuleData{Name: "all temperatures"}, func(ctx context.Context, client *Client, ruleData RuleData, e event.Event) {
+		atomic.AddInt32(&calls, 1)
+	}, OnItemStateChanged("Temperature_*"))
+	client.activateRules()
+
+	client.userEventBus.Publish(event.NewItemStateChanged("Temperature_Kitchen", "Decimal", "20", "Decimal", "21"))
+	client.userEventBus.Publish(event.NewItemStateChanged("Temperature_Lounge", "Decimal", "19", "Decimal", "20"))
+	client.userEventBus.Publish(event.NewItemStateChanged("Humidity_Kitchen", "Decimal", "50", "Decimal", "51"))
+	client.userEventBus.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
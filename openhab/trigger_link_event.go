@@ -0,0 +1,76 @@
+package openhab
+
+import "github.com/creativeprojects/gopenhab/event"
+
+type itemChannelLinkTrigger struct {
+	baseTrigger
+	item      event.Pattern
+	channel   event.Pattern
+	eventType event.Type
+	subID     int
+}
+
+// OnItemChannelLinkAdded triggers the rule when a link between an item and a channel is added.
+// Pass an empty string to item and/or channel to receive the events from any item and/or any channel.
+// Both item and channel can also be a glob pattern or a regular expression (see event.Pattern).
+// The event received is of type event.ItemChannelLinkAdded.
+func OnItemChannelLinkAdded(item, channel string) *itemChannelLinkTrigger {
+	return &itemChannelLinkTrigger{
+		item:      event.NewPattern(item),
+		channel:   event.NewPattern(channel),
+		eventType: event.TypeItemChannelLinkAdded,
+	}
+}
+
+// OnItemChannelLinkRemoved triggers the rule when a link between an item and a channel is removed.
+// Pass an empty string to item and/or channel to receive the events from any item and/or any channel.
+// Both item and channel can also be a glob pattern or a regular expression (see event.Pattern).
+// The event received is of type event.ItemChannelLinkRemoved.
+func OnItemChannelLinkRemoved(item, channel string) *itemChannelLinkTrigger {
+	return &itemChannelLinkTrigger{
+		item:      event.NewPattern(item),
+		channel:   event.NewPattern(channel),
+		eventType: event.TypeItemChannelLinkRemoved,
+	}
+}
+
+func (c *itemChannelLinkTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	if _, err := compilePattern(c.item.String()); err != nil {
+		return err
+	}
+	if _, err := compilePattern(c.channel.String()); err != nil {
+		return err
+	}
+	// the topic contains both the item name and the channel UID: we filter them in match
+	c.subID = c.subscribe(client, "", c.eventType, run, c.match)
+	return nil
+}
+
+func (c *itemChannelLinkTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *itemChannelLinkTrigger) match(e event.Event) bool {
+	var link event.Link
+	switch ev := e.(type) {
+	case event.ItemChannelLinkAdded:
+		link = ev.Link
+	case event.ItemChannelLinkRemoved:
+		link = ev.Link
+	default:
+		panic("expected event of type event.ItemChannelLinkAdded or event.ItemChannelLinkRemoved")
+	}
+	if e.Type() != c.eventType {
+		return false
+	}
+	return c.item.Match(link.ItemName) && c.channel.Match(link.ChannelUID)
+}
+
+// Interface
+var _ Trigger = &itemChannelLinkTrigger{}
@@ -0,0 +1,35 @@
+package openhab
+
+import (
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchingLinkEvent(t *testing.T) {
+	t.Parallel()
+	link := event.Link{ItemName: "Lamp", ChannelUID: "hue:0220:1:5:color"}
+	testEvents := []struct {
+		e       event.Event
+		trigger Trigger
+		match   bool
+	}{
+		{event.NewItemChannelLinkAdded(link), OnItemChannelLinkAdded("", ""), true},
+		{event.NewItemChannelLinkAdded(link), OnItemChannelLinkAdded("Lamp", ""), true},
+		{event.NewItemChannelLinkAdded(link), OnItemChannelLinkAdded("Lamp", "hue:0220:1:5:color"), true},
+		{event.NewItemChannelLinkAdded(link), OnItemChannelLinkAdded("Lamp", "hue:0220:1:5:brightness"), false},
+		{event.NewItemChannelLinkAdded(link), OnItemChannelLinkAdded("Other", ""), false},
+		{event.NewItemChannelLinkAdded(link), OnItemChannelLinkRemoved("", ""), false},
+		{event.NewItemChannelLinkRemoved(link), OnItemChannelLinkRemoved("", "hue:0220:1:5:color"), true},
+		{event.NewItemChannelLinkRemoved(link), OnItemChannelLinkRemoved("", "hue:*:color"), true},
+		{event.NewItemChannelLinkRemoved(link), OnItemChannelLinkRemoved("/^(Lamp|Light)$/", "zwave:*"), false},
+	}
+
+	for _, testEvent := range testEvents {
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testEvent.match, testEvent.trigger.match(testEvent.e))
+		})
+	}
+}
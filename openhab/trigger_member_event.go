@@ -0,0 +1,88 @@
+package openhab
+
+import (
+	"github.com/creativeprojects/gopenhab/event"
+)
+
+type memberOfTrigger struct {
+	baseTrigger
+	group     string
+	eventType event.Type
+	client    subscriber
+	subID     int
+}
+
+// OnMemberOfStateChanged triggers the rule when a direct member of the group changed state.
+// The event received by the rule is the event.ItemStateChanged of the triggering item.
+// This is an equivalent of the DSL rule:
+//
+// Member of <group> changed
+func OnMemberOfStateChanged(group string) *memberOfTrigger {
+	return &memberOfTrigger{
+		group:     group,
+		eventType: event.TypeItemStateChanged,
+	}
+}
+
+// OnMemberOfReceivedCommand triggers the rule when a direct member of the group received a command.
+// The event received by the rule is the event.ItemReceivedCommand of the triggering item.
+// This is an equivalent of the DSL rule:
+//
+// Member of <group> received command
+func OnMemberOfReceivedCommand(group string) *memberOfTrigger {
+	return &memberOfTrigger{
+		group:     group,
+		eventType: event.TypeItemCommand,
+	}
+}
+
+// OnMemberOfReceivedState triggers the rule when a direct member of the group received an update.
+// The event received by the rule is the event.ItemReceivedState of the triggering item.
+// This is an equivalent of the DSL rule:
+//
+// Member of <group> received update
+func OnMemberOfReceivedState(group string) *memberOfTrigger {
+	return &memberOfTrigger{
+		group:     group,
+		eventType: event.TypeItemState,
+	}
+}
+
+func (c *memberOfTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	c.client = client
+	// membership is checked on each event so members added later are also triggering the rule
+	c.subID = c.subscribe(client, "", c.eventType, run, c.match)
+	return nil
+}
+
+func (c *memberOfTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *memberOfTrigger) match(e event.Event) bool {
+	if c.client == nil {
+		return false
+	}
+	var itemName string
+	switch ev := e.(type) {
+	case event.ItemStateChanged:
+		itemName = ev.ItemName
+	case event.ItemReceivedCommand:
+		itemName = ev.ItemName
+	case event.ItemReceivedState:
+		itemName = ev.ItemName
+	default:
+		// ItemStateUpdated events are also sent with the TypeItemState type
+		return false
+	}
+	return c.client.isMemberOf(itemName, c.group)
+}
+
+// Interface
+var _ Trigger = &memberOfTrigger{}
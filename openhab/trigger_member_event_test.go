@@ -0,0 +1,121 @@
+package openhab
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+	"github.com/creativeprojects/gopenhab/openhabtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchingMemberOfEvent(t *testing.T) {
+	t.Parallel()
+	testEvents := []struct {
+		e       event.Event
+		trigger *memberOfTrigger
+		match   bool
+	}{
+		{event.NewItemStateChanged("Light1", "OnOff", "OFF", "OnOff", "ON"), OnMemberOfStateChanged("Lights"), true},
+		{event.NewItemStateChanged("Heater", "OnOff", "OFF", "OnOff", "ON"), OnMemberOfStateChanged("Lights"), false},
+		{event.NewItemReceivedCommand("Light1", "OnOff", "ON"), OnMemberOfReceivedCommand("Lights"), true},
+		{event.NewItemReceivedCommand("Light1", "OnOff", "ON"), OnMemberOfReceivedCommand("Heaters"), false},
+		{event.NewItemReceivedState("Light1", "OnOff", "ON"), OnMemberOfReceivedState("Lights"), true},
+		{event.NewItemStateUpdated("Light1", "OnOff", "ON"), OnMemberOfReceivedState("Lights"), false},
+	}
+
+	for _, testEvent := range testEvents {
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+			client := newMockSubscriber(t)
+			client.On("subscribe", "", mock.Anything, mock.Anything).Return(1)
+			client.On("isMemberOf", mock.Anything, mock.Anything).Return(func(itemName, groupName string) bool {
+				return itemName == "Light1" && groupName == "Lights"
+			}).Maybe()
+
+			err := testEvent.trigger.activate(client, func(ev event.Event) {}, RuleData{})
+			require.NoError(t, err)
+			assert.Equal(t, testEvent.match, testEvent.trigger.match(testEvent.e))
+		})
+	}
+}
+
+func TestMemberOfTriggerNotActivated(t *testing.T) {
+	t.Parallel()
+	trigger := OnMemberOfStateChanged("Lights")
+	assert.False(t, trigger.match(event.NewItemStateChanged("Light1", "OnOff", "OFF", "OnOff", "ON")))
+}
+
+func TestMemberOfTriggerActivatedTwice(t *testing.T) {
+	t.Parallel()
+	client := newMockSubscriber(t)
+	client.On("subscribe", "", event.TypeItemCommand, mock.Anything).Return(1).Once()
+
+	trigger := OnMemberOfReceivedCommand("Lights")
+	err := trigger.activate(client, func(ev event.Event) {}, RuleData{})
+	require.NoError(t, err)
+	err = trigger.activate(client, func(ev event.Event) {}, RuleData{})
+	assert.ErrorIs(t, err, ErrRuleAlreadyActivated)
+}
+
+func TestMemberOfStateChangedWithItemsAddedLater(t *testing.T) {
+	t.Parallel()
+	server := openhabtest.NewServer(openhabtest.Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetItem(api.Item{Name: "Lights", Type: "Group"}))
+	require.NoError(t, server.SetItem(api.Item{Name: "Light1", Type: "Switch", State: "OFF", GroupNames: []string{"Lights"}}))
+	require.NoError(t, server.SetItem(api.Item{Name: "Heater", Type: "Switch", State: "OFF"}))
+
+	var calls int32
+	var triggered atomic.Value
+	client := NewClient(Config{URL: server.URL()})
+	client.addInternalRules()
+	client.AddRule(RuleData{Name: "lights"}, func(ctx context.Context, client *Client, ruleData RuleData, e event.Event) {
+		atomic.AddInt32(&calls, 1)
+		if ev, ok := e.(event.ItemStateChanged); ok {
+			triggered.Store(ev.ItemName)
+		}
+	}, OnMemberOfStateChanged("Lights"))
+	client.activateRules()
+
+	client.userEventBus.Publish(event.NewItemStateChanged("Light1", "OnOff", "OFF", "OnOff", "ON"))
+	client.userEventBus.Publish(event.NewItemStateChanged("Heater", "OnOff", "OFF", "OnOff", "ON"))
+	client.userEventBus.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, "Light1", triggered.Load())
+
+	// new member added to the group
+	client.systemEventBus.Publish(event.NewItemAdded(event.Item{Name: "Light2", Type: "Switch", GroupNames: []string{"Lights"}}))
+	client.systemEventBus.Wait()
+
+	client.userEventBus.Publish(event.NewItemStateChanged("Light2", "OnOff", "OFF", "OnOff", "ON"))
+	client.userEventBus.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.Equal(t, "Light2", triggered.Load())
+
+	// existing item moved into the group
+	client.systemEventBus.Publish(event.NewItemUpdated(
+		event.Item{Name: "Heater", Type: "Switch"},
+		event.Item{Name: "Heater", Type: "Switch", GroupNames: []string{"Lights"}},
+	))
+	client.systemEventBus.Wait()
+
+	client.userEventBus.Publish(event.NewItemStateChanged("Heater", "OnOff", "ON", "OnOff", "OFF"))
+	client.userEventBus.Wait()
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Equal(t, "Heater", triggered.Load())
+
+	// an item missing from the cache is not loaded from openHAB
+	require.NoError(t, server.SetItem(api.Item{Name: "Light3", Type: "Switch", State: "OFF", GroupNames: []string{"Lights"}}))
+	client.userEventBus.Publish(event.NewItemStateChanged("Light3", "OnOff", "OFF", "OnOff", "ON"))
+	client.userEventBus.Wait()
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Nil(t, client.items.cachedItem("Light3"))
+
+	assert.NoError(t, server.ItemsErr())
+}
@@ -0,0 +1,89 @@
+package openhab
+
+import "github.com/creativeprojects/gopenhab/event"
+
+type sitemapWidgetUpdatedTrigger struct {
+	baseTrigger
+	sitemap string
+	subID   int
+}
+
+// OnSitemapWidgetUpdated triggers the rule when a widget of a subscribed sitemap page is updated
+// (see Client.SubscribeSitemapEvents). Pass an empty string to sitemap to be notified for any sitemap.
+// The event received is of type event.SitemapWidgetUpdated.
+func OnSitemapWidgetUpdated(sitemap string) *sitemapWidgetUpdatedTrigger {
+	return &sitemapWidgetUpdatedTrigger{
+		sitemap: sitemap,
+	}
+}
+
+func (c *sitemapWidgetUpdatedTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	if _, err := compilePattern(c.sitemap); err != nil {
+		return err
+	}
+	c.subID = c.subscribe(client, c.sitemap, event.TypeSitemapWidgetUpdated, run, c.match)
+	return nil
+}
+
+func (c *sitemapWidgetUpdatedTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *sitemapWidgetUpdatedTrigger) match(e event.Event) bool {
+	if _, ok := e.(event.SitemapWidgetUpdated); !ok {
+		panic("expected event of type event.SitemapWidgetUpdated")
+	}
+	return true
+}
+
+// Interface
+var _ Trigger = &sitemapWidgetUpdatedTrigger{}
+
+type sitemapChangedTrigger struct {
+	baseTrigger
+	sitemap string
+	subID   int
+}
+
+// OnSitemapChanged triggers the rule when the definition of a subscribed sitemap has changed
+// (see Client.SubscribeSitemapEvents). Pass an empty string to sitemap to be notified for any sitemap.
+// The event received is of type event.SitemapChanged.
+func OnSitemapChanged(sitemap string) *sitemapChangedTrigger {
+	return &sitemapChangedTrigger{
+		sitemap: sitemap,
+	}
+}
+
+func (c *sitemapChangedTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	if _, err := compilePattern(c.sitemap); err != nil {
+		return err
+	}
+	c.subID = c.subscribe(client, c.sitemap, event.TypeSitemapChanged, run, c.match)
+	return nil
+}
+
+func (c *sitemapChangedTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *sitemapChangedTrigger) match(e event.Event) bool {
+	if _, ok := e.(event.SitemapChanged); !ok {
+		panic("expected event of type event.SitemapChanged")
+	}
+	return true
+}
+
+// Interface
+var _ Trigger = &sitemapChangedTrigger{}
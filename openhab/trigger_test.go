@@ -68,3 +68,29 @@ func TestBaseTriggerSubscribeNilCallback(t *testing.T) {
 	subscribedCallback(event.NewSystemEvent(event.TypeClientDisconnected))
 	subscribedCallback(event.NewSystemEvent(event.TypeClientConnected))
 }
+
+func TestTriggerInvalidPattern(t *testing.T) {
+	t.Parallel()
+	triggers := []Trigger{
+		OnItemReceivedCommand("Temperature_[", nil),
+		OnItemReceivedState("/Temperature_(/", nil),
+		OnItemStateChanged("Temperature_["),
+		OnGroupStateUpdated("Temperatures_["),
+		OnChannelTriggered("astro:sun:[", ""),
+		OnInboxAdded("hue:["),
+		OnItemChannelLinkAdded("Lamp", "hue:["),
+		OnItemChannelLinkRemoved("/Lamp(/", ""),
+		OnThingReceivedStatusInfo("zwave:[", ThingStatusOnline),
+		OnThingReceivedStatusInfoChanged("zwave:["),
+		OnThingAdded("zwave:["),
+		OnSitemapWidgetUpdated("home["),
+		OnSitemapChanged("home["),
+	}
+
+	for _, trigger := range triggers {
+		// the mock fails the test if subscribe is called
+		client := newMockSubscriber(t)
+		err := trigger.activate(client, func(ev event.Event) {}, RuleData{})
+		assert.Error(t, err)
+	}
+}
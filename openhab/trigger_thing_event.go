@@ -28,6 +28,9 @@ func (c *thingReceivedStatusInfoTrigger) activate(client subscriber, run func(ev
 	if c.subID > 0 {
 		return ErrRuleAlreadyActivated
 	}
+	if _, err := compilePattern(c.thing); err != nil {
+		return err
+	}
 	c.subID = c.subscribe(client, c.thing, event.TypeThingStatusInfo, run, c.match)
 	return nil
 }
@@ -116,6 +119,9 @@ func (c *thingReceivedStatusInfoChangedTrigger) activate(client subscriber, run
 	if c.subID > 0 {
 		return ErrRuleAlreadyActivated
 	}
+	if _, err := compilePattern(c.thing); err != nil {
+		return err
+	}
 	c.subID = c.subscribe(client, c.thing, event.TypeThingStatusInfoChanged, run, c.match)
 	return nil
 }
@@ -157,3 +163,74 @@ func (c *thingReceivedStatusInfoChangedTrigger) match(e event.Event) bool {
 
 // Interface
 var _ Trigger = &thingReceivedStatusInfoChangedTrigger{}
+
+// thingRegistryTrigger for things added, removed or updated in the thing registry
+type thingRegistryTrigger struct {
+	baseTrigger
+	thing     string
+	eventType event.Type
+	subID     int
+}
+
+// OnThingAdded triggers the rule when a thing is added to the thing registry.
+// Pass an empty string to thing to be notified of any thing added.
+// This is an equivalent of the JSR223 trigger:
+//
+// ThingAddedTrigger
+func OnThingAdded(thing string) *thingRegistryTrigger {
+	return &thingRegistryTrigger{
+		thing:     thing,
+		eventType: event.TypeThingAdded,
+	}
+}
+
+// OnThingRemoved triggers the rule when a thing is removed from the thing registry.
+// Pass an empty string to thing to be notified of any thing removed.
+// This is an equivalent of the JSR223 trigger:
+//
+// ThingRemovedTrigger
+func OnThingRemoved(thing string) *thingRegistryTrigger {
+	return &thingRegistryTrigger{
+		thing:     thing,
+		eventType: event.TypeThingRemoved,
+	}
+}
+
+// OnThingUpdated triggers the rule when a thing definition is updated in the thing registry
+// (label, configuration, properties, etc.)
+// Pass an empty string to thing to be notified of any thing updated.
+// This is an equivalent of the JSR223 trigger:
+//
+// ThingUpdatedTrigger
+func OnThingUpdated(thing string) *thingRegistryTrigger {
+	return &thingRegistryTrigger{
+		thing:     thing,
+		eventType: event.TypeThingUpdated,
+	}
+}
+
+func (c *thingRegistryTrigger) activate(client subscriber, run func(ev event.Event), ruleData RuleData) error {
+	if c.subID > 0 {
+		return ErrRuleAlreadyActivated
+	}
+	if _, err := compilePattern(c.thing); err != nil {
+		return err
+	}
+	c.subID = c.subscribe(client, c.thing, c.eventType, run, c.match)
+	return nil
+}
+
+func (c *thingRegistryTrigger) deactivate(client subscriber) {
+	if c.subID > 0 {
+		client.unsubscribe(c.subID)
+		c.subID = 0
+	}
+}
+
+func (c *thingRegistryTrigger) match(e event.Event) bool {
+	// the event bus already filters on the thing UID and the event type
+	return e.Type() == c.eventType
+}
+
+// Interface
+var _ Trigger = &thingRegistryTrigger{}
@@ -87,6 +87,27 @@ func TestMatchingThingEvent(t *testing.T) {
 			OnThingReceivedStatusInfoChangedFromTo("TestItem", ThingStatusOffline, ThingStatusOnline),
 			true,
 		},
+		// thing registry
+		{
+			event.NewThingAdded(event.Thing{UID: "TestItem"}),
+			OnThingAdded("TestItem"),
+			true,
+		},
+		{
+			event.NewThingRemoved(event.Thing{UID: "TestItem"}),
+			OnThingRemoved(""),
+			true,
+		},
+		{
+			event.NewThingUpdated(event.Thing{UID: "TestItem"}, event.Thing{UID: "TestItem"}),
+			OnThingUpdated("TestItem"),
+			true,
+		},
+		{
+			event.NewThingRemoved(event.Thing{UID: "TestItem"}),
+			OnThingAdded("TestItem"),
+			false,
+		},
 	}
 
 	for _, testEvent := range testEvents {
@@ -153,6 +174,24 @@ func TestThingEventSubscription(t *testing.T) {
 			),
 			1,
 		},
+		{
+			OnThingAdded("thing"),
+			event.TypeThingAdded,
+			event.NewThingAdded(event.Thing{UID: "thing"}),
+			1,
+		},
+		{
+			OnThingRemoved("thing"),
+			event.TypeThingRemoved,
+			event.NewThingRemoved(event.Thing{UID: "thing"}),
+			1,
+		},
+		{
+			OnThingUpdated("thing"),
+			event.TypeThingUpdated,
+			event.NewThingUpdated(event.Thing{UID: "thing"}, event.Thing{UID: "thing", Label: "thing"}),
+			1,
+		},
 	}
 
 	for _, testFixture := range testFixtures {
@@ -0,0 +1,97 @@
+package openhab
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Dimension is the physical quantity measured by a unit, like the dimension of a "Number:Temperature" item
+type Dimension string
+
+const (
+	DimensionNone        Dimension = ""
+	DimensionTemperature Dimension = "Temperature"
+	DimensionPower       Dimension = "Power"
+	DimensionEnergy      Dimension = "Energy"
+	DimensionLength      Dimension = "Length"
+	DimensionTime        Dimension = "Time"
+)
+
+// unit converts a value to and from the base unit of its dimension: base = value * factor + offset
+type unit struct {
+	dimension Dimension
+	factor    float64
+	offset    float64
+}
+
+var units = map[string]unit{
+	// temperature: base unit is K
+	"K":  {DimensionTemperature, 1, 0},
+	"°C": {DimensionTemperature, 1, 273.15},
+	"°F": {DimensionTemperature, 5.0 / 9.0, 459.67 * 5.0 / 9.0},
+	// power: base unit is W
+	"mW": {DimensionPower, 0.001, 0},
+	"W":  {DimensionPower, 1, 0},
+	"kW": {DimensionPower, 1e3, 0},
+	"MW": {DimensionPower, 1e6, 0},
+	// energy: base unit is J
+	"J":   {DimensionEnergy, 1, 0},
+	"kJ":  {DimensionEnergy, 1e3, 0},
+	"Wh":  {DimensionEnergy, 3600, 0},
+	"kWh": {DimensionEnergy, 3.6e6, 0},
+	"MWh": {DimensionEnergy, 3.6e9, 0},
+	// length: base unit is m
+	"mm": {DimensionLength, 0.001, 0},
+	"cm": {DimensionLength, 0.01, 0},
+	"m":  {DimensionLength, 1, 0},
+	"km": {DimensionLength, 1e3, 0},
+	"in": {DimensionLength, 0.0254, 0},
+	"ft": {DimensionLength, 0.3048, 0},
+	"mi": {DimensionLength, 1609.344, 0},
+	// time: base unit is s
+	"ms":  {DimensionTime, 0.001, 0},
+	"s":   {DimensionTime, 1, 0},
+	"min": {DimensionTime, 60, 0},
+	"h":   {DimensionTime, 3600, 0},
+	"d":   {DimensionTime, 86400, 0},
+}
+
+// getUnit returns the definition of a unit symbol. An empty symbol is a valid unit without dimension.
+func getUnit(symbol string) (unit, error) {
+	if symbol == "" {
+		return unit{dimension: DimensionNone, factor: 1}, nil
+	}
+	if definition, ok := units[symbol]; ok {
+		return definition, nil
+	}
+	return unit{}, fmt.Errorf("%w %q", ErrUnknownUnit, symbol)
+}
+
+// convertUnit converts a value from one unit to another of the same dimension
+func convertUnit(value float64, from, to string) (float64, error) {
+	if from == to {
+		return value, nil
+	}
+	fromUnit, err := getUnit(from)
+	if err != nil {
+		return 0, err
+	}
+	toUnit, err := getUnit(to)
+	if err != nil {
+		return 0, err
+	}
+	if fromUnit.dimension != toUnit.dimension || fromUnit.dimension == DimensionNone {
+		return 0, fmt.Errorf("%w: cannot convert %q to %q", ErrIncompatibleUnit, from, to)
+	}
+	base := value*fromUnit.factor + fromUnit.offset
+	return roundConversion((base - toUnit.offset) / toUnit.factor), nil
+}
+
+// roundConversion removes the floating point noise of a conversion, keeping 12 significant digits
+func roundConversion(value float64) float64 {
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(value, 'g', 12, 64), 64)
+	if err != nil {
+		return value
+	}
+	return rounded
+}
@@ -0,0 +1,170 @@
+package openhab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalStateDimension(t *testing.T) {
+	t.Parallel()
+	fixtures := []struct {
+		state     string
+		dimension Dimension
+	}{
+		{"21 °C", DimensionTemperature},
+		{"70 °F", DimensionTemperature},
+		{"1500 W", DimensionPower},
+		{"12.5 kWh", DimensionEnergy},
+		{"3 km", DimensionLength},
+		{"90 min", DimensionTime},
+		{"42", DimensionNone},
+		{"42 lx", DimensionNone},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.state, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, fixture.dimension, MustParseDecimalState(fixture.state).Dimension())
+		})
+	}
+}
+
+func TestDecimalStateConvertTo(t *testing.T) {
+	t.Parallel()
+	fixtures := []struct {
+		from     string
+		unit     string
+		expected DecimalState
+	}{
+		{"20 °C", "°F", NewDecimalState(68, "°F")},
+		{"68 °F", "°C", NewDecimalState(20, "°C")},
+		{"0 °C", "K", NewDecimalState(273.15, "K")},
+		{"212 °F", "K", NewDecimalState(373.15, "K")},
+		{"1500 W", "kW", NewDecimalState(1.5, "kW")},
+		{"2.5 kWh", "Wh", NewDecimalState(2500, "Wh")},
+		{"90 min", "h", NewDecimalState(1.5, "h")},
+		{"2 h", "s", NewDecimalState(7200, "s")},
+		{"1 km", "m", NewDecimalState(1000, "m")},
+		{"12", "", NewDecimalState(12, "")},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.from+" to "+fixture.unit, func(t *testing.T) {
+			t.Parallel()
+			result, err := MustParseDecimalState(fixture.from).ConvertTo(fixture.unit)
+			require.NoError(t, err)
+			assert.Equal(t, fixture.expected, result)
+		})
+	}
+}
+
+func TestDecimalStateConvertToIncompatibleUnit(t *testing.T) {
+	t.Parallel()
+	_, err := MustParseDecimalState("20 °C").ConvertTo("kW")
+	assert.ErrorIs(t, err, ErrIncompatibleUnit)
+
+	_, err = MustParseDecimalState("20").ConvertTo("°C")
+	assert.ErrorIs(t, err, ErrIncompatibleUnit)
+
+	_, err = MustParseDecimalState("20 °C").ConvertTo("parsec")
+	assert.ErrorIs(t, err, ErrUnknownUnit)
+}
+
+func TestDecimalStateArithmetic(t *testing.T) {
+	t.Parallel()
+	sum, err := MustParseDecimalState("1.5 kW").Add(MustParseDecimalState("500 W"))
+	require.NoError(t, err)
+	assert.Equal(t, NewDecimalState(2, "kW"), sum)
+
+	difference, err := MustParseDecimalState("2 h").Subtract(MustParseDecimalState("30 min"))
+	require.NoError(t, err)
+	assert.Equal(t, NewDecimalState(1.5, "h"), difference)
+
+	sum, err = MustParseDecimalState("2").Add(MustParseDecimalState("3"))
+	require.NoError(t, err)
+	assert.Equal(t, NewDecimalState(5, ""), sum)
+
+	_, err = MustParseDecimalState("1 kWh").Add(MustParseDecimalState("1 kW"))
+	assert.ErrorIs(t, err, ErrIncompatibleUnit)
+
+	_, err = MustParseDecimalState("1 kWh").Subtract(MustParseDecimalState("1"))
+	assert.ErrorIs(t, err, ErrIncompatibleUnit)
+
+	assert.Equal(t, NewDecimalState(3, "kWh"), MustParseDecimalState("1.5 kWh").Multiply(2))
+	assert.Equal(t, NewDecimalState(0.75, "kWh"), MustParseDecimalState("1.5 kWh").Divide(2))
+}
+
+func TestDecimalStateCompare(t *testing.T) {
+	t.Parallel()
+	fixtures := []struct {
+		left, right string
+		greater     bool
+		less        bool
+	}{
+		{"22 °C", "21 °C", true, false},
+		{"22 °C", "70 °F", true, false},
+		{"20 °C", "70 °F", false, true},
+		{"20 °C", "68 °F", false, false},
+		{"2", "1", true, false},
+		{"1 kWh", "999.99 Wh", true, false},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.left+" "+fixture.right, func(t *testing.T) {
+			t.Parallel()
+			greater, err := MustParseDecimalState(fixture.left).GreaterThan(MustParseDecimalState(fixture.right))
+			require.NoError(t, err)
+			assert.Equal(t, fixture.greater, greater)
+
+			less, err := MustParseDecimalState(fixture.left).LessThan(MustParseDecimalState(fixture.right))
+			require.NoError(t, err)
+			assert.Equal(t, fixture.less, less)
+		})
+	}
+}
+
+func TestDecimalStateCompareIncompatibleUnits(t *testing.T) {
+	t.Parallel()
+	_, err := MustParseDecimalState("22 °C").GreaterThan(MustParseDecimalState("21"))
+	assert.ErrorIs(t, err, ErrIncompatibleUnit)
+
+	_, err = MustParseDecimalState("22 °C").LessThan(MustParseDecimalState("100 W"))
+	assert.ErrorIs(t, err, ErrIncompatibleUnit)
+
+	result, err := MustParseDecimalState("20 °C").Compare(MustParseDecimalState("68 °F"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, result)
+
+	_, err = MustParseDecimalState("20 °C").Compare(MustParseDecimalState("68 W"))
+	assert.ErrorIs(t, err, ErrIncompatibleUnit)
+}
+
+func TestDecimalStateConvertToKeepsPrecision(t *testing.T) {
+	t.Parallel()
+	result, err := MustParseDecimalState("1234.57 kWh").ConvertTo("MWh")
+	require.NoError(t, err)
+	assert.Equal(t, 1.23457, result.Float64())
+	assert.Equal(t, "1.23457 MWh", result.String())
+
+	result, err = MustParseDecimalState("1 Wh").ConvertTo("MWh")
+	require.NoError(t, err)
+	assert.Equal(t, 1e-6, result.Float64())
+}
+
+func TestItemDimensionOnUnitlessState(t *testing.T) {
+	t.Parallel()
+	item := newTestItem(nil, "temperature", "Number:Temperature", "21.5")
+	state, ok := item.getInternalState().(DecimalState)
+	require.True(t, ok)
+	assert.Equal(t, "", state.Unit())
+	assert.Equal(t, DimensionTemperature, state.Dimension())
+
+	// the unit is giving the dimension
+	item = newTestItem(nil, "energy", "Number:Energy", "12 kWh")
+	assert.Equal(t, DimensionEnergy, item.getInternalState().(DecimalState).Dimension())
+
+	item = newTestItem(nil, "number", "Number", "12")
+	assert.Equal(t, DimensionNone, item.getInternalState().(DecimalState).Dimension())
+}
@@ -0,0 +1,164 @@
+package openhabtest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+// ActionHandler is called by the mock server when a thing action is invoked.
+// It receives the inputs of the action and returns its outputs.
+// An error returned by the handler is sent back to the client as an internal server error.
+type ActionHandler func(inputs map[string]any) (map[string]any, error)
+
+type thingAction struct {
+	action  api.ThingAction
+	handler ActionHandler
+}
+
+type actionsHandler struct {
+	log           Logger
+	actions       map[string][]thingAction
+	actionsLocker sync.Mutex
+	err           error
+}
+
+func newActionsHandler(log Logger) *actionsHandler {
+	return &actionsHandler{
+		log:     log,
+		actions: make(map[string][]thingAction, 10),
+	}
+}
+
+func (h *actionsHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	encoder := json.NewEncoder(resp)
+
+	if len(parts) == 3 && req.Method == http.MethodGet {
+		// request is: get all actions of a thing
+		h.sendActions(parts[2], encoder, resp)
+		return
+	}
+
+	if len(parts) == 4 && req.Method == http.MethodPost {
+		// request is: invoke action
+		h.invokeAction(parts[2], parts[3], encoder, resp, req)
+		return
+	}
+
+	// fallback
+	resp.WriteHeader(http.StatusNotFound)
+}
+
+func (h *actionsHandler) sendActions(thingUID string, encoder *json.Encoder, resp http.ResponseWriter) {
+	actions := h.getActions(thingUID)
+	data := make([]api.ThingAction, 0, len(actions))
+	for _, action := range actions {
+		data = append(data, action.action)
+	}
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *actionsHandler) invokeAction(thingUID, actionUID string, encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	action, ok := h.getAction(thingUID, actionUID)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	inputs := make(map[string]any)
+	err := json.NewDecoder(req.Body).Decode(&inputs)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for _, input := range action.action.Inputs {
+		if _, found := inputs[input.Name]; input.Required && !found {
+			h.log.Logf("missing required input %q for action %q", input.Name, actionUID)
+			resp.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	outputs := map[string]any{}
+	if action.handler != nil {
+		outputs, err = action.handler(inputs)
+		if err != nil {
+			h.log.Logf("action %q returned an error: %s", actionUID, err)
+			resp.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if outputs == nil {
+			outputs = map[string]any{}
+		}
+	}
+	h.err = errors.Join(h.err, encoder.Encode(&outputs))
+}
+
+// setAction adds the action to the thing, or replaces the existing one (with the same action UID)
+func (h *actionsHandler) setAction(thingUID string, action api.ThingAction, handler ActionHandler) error {
+	if thingUID == "" {
+		return errors.New("missing thing UID")
+	}
+	if action.ActionUID == "" {
+		return errors.New("missing action UID")
+	}
+	h.actionsLocker.Lock()
+	defer h.actionsLocker.Unlock()
+
+	if action.Inputs == nil {
+		action.Inputs = []api.ActionInput{}
+	}
+	if action.Outputs == nil {
+		action.Outputs = []api.ActionOutput{}
+	}
+	if action.Tags == nil {
+		action.Tags = []string{}
+	}
+	if action.Visibility == "" {
+		action.Visibility = "VISIBLE"
+	}
+	actions := h.actions[thingUID]
+	for i := range actions {
+		if actions[i].action.ActionUID == action.ActionUID {
+			actions[i] = thingAction{action: action, handler: handler}
+			return nil
+		}
+	}
+	h.actions[thingUID] = append(actions, thingAction{action: action, handler: handler})
+	return nil
+}
+
+// removeActions removes all the actions of a thing. It doesn't return an error if the thing has no action.
+func (h *actionsHandler) removeActions(thingUID string) error {
+	if thingUID == "" {
+		return errors.New("missing thing UID")
+	}
+	h.actionsLocker.Lock()
+	defer h.actionsLocker.Unlock()
+
+	delete(h.actions, thingUID)
+	return nil
+}
+
+func (h *actionsHandler) getActions(thingUID string) []thingAction {
+	h.actionsLocker.Lock()
+	defer h.actionsLocker.Unlock()
+
+	return append([]thingAction(nil), h.actions[thingUID]...)
+}
+
+func (h *actionsHandler) getAction(thingUID, actionUID string) (thingAction, bool) {
+	for _, action := range h.getActions(thingUID) {
+		if action.action.ActionUID == actionUID {
+			return action, true
+		}
+	}
+	return thingAction{}, false
+}
@@ -73,7 +73,147 @@ func EventString(e event.Event, prefix string) (string, string) {
 		}
 		return topic, string(rawEvent)
 
+	case event.GroupItemStateUpdated:
+		return encodeEvent(prefix+ev.Topic(), api.EventGroupItemStateUpdated, api.EventState{
+			Type:  ev.StateType,
+			Value: ev.State,
+		})
+
+	case event.GroupItemStateChanged:
+		return encodeEvent(prefix+ev.Topic(), api.EventGroupItemStateChanged, api.EventStateChanged{
+			Type:     ev.NewStateType,
+			Value:    ev.NewState,
+			OldType:  ev.PreviousStateType,
+			OldValue: ev.PreviousState,
+		})
+
+	case event.ItemAdded:
+		return encodeEvent(prefix+ev.Topic(), api.EventItemAdded, apiItem(ev.Item))
+
+	case event.ItemRemoved:
+		return encodeEvent(prefix+ev.Topic(), api.EventItemRemoved, apiItem(ev.Item))
+
+	case event.ItemUpdated:
+		return encodeEvent(prefix+ev.Topic(), api.EventItemUpdated, []api.Item{
+			apiItem(ev.Item),
+			apiItem(ev.OldItem),
+		})
+
+	case event.ThingStatusInfoEvent:
+		return encodeEvent(prefix+ev.Topic(), api.EventThingStatusInfo, api.ThingStatusInfo{
+			Status:       ev.Status,
+			StatusDetail: ev.StatusDetail,
+			Description:  ev.Description,
+		})
+
+	case event.ThingStatusInfoChangedEvent:
+		return encodeEvent(prefix+ev.Topic(), api.EventThingStatusInfoChanged, []api.ThingStatusInfo{
+			{
+				Status:       ev.NewStatus,
+				StatusDetail: ev.NewStatusDetail,
+				Description:  ev.NewDescription,
+			},
+			{
+				Status:       ev.PreviousStatus,
+				StatusDetail: ev.PreviousStatusDetail,
+				Description:  ev.PreviousDescription,
+			},
+		})
+
+	case event.ChannelTriggered:
+		return encodeEvent(prefix+ev.Topic(), api.EventChannelTriggered, api.EventTriggered{
+			Event:   ev.Event,
+			Channel: ev.ChannelName,
+		})
+
+	case event.ItemChannelLinkAdded:
+		return encodeEvent(prefix+ev.Topic(), api.EventItemChannelLinkAdded, apiLink(ev.Link))
+
+	case event.ItemChannelLinkRemoved:
+		return encodeEvent(prefix+ev.Topic(), api.EventItemChannelLinkRemoved, apiLink(ev.Link))
+
+	case event.InboxAdded:
+		return encodeEvent(prefix+ev.Topic(), api.EventInboxAdded, apiDiscoveryResult(ev.DiscoveryResult))
+
+	case event.InboxRemoved:
+		return encodeEvent(prefix+ev.Topic(), api.EventInboxRemoved, apiDiscoveryResult(ev.DiscoveryResult))
+
+	case event.InboxUpdated:
+		return encodeEvent(prefix+ev.Topic(), api.EventInboxUpdated, apiDiscoveryResult(ev.DiscoveryResult))
+
+	case event.ThingAdded:
+		return encodeEvent(prefix+ev.Topic(), api.EventThingAdded, apiThing(ev.Thing))
+
+	case event.ThingRemoved:
+		return encodeEvent(prefix+ev.Topic(), api.EventThingRemoved, apiThing(ev.Thing))
+
+	case event.ThingUpdated:
+		return encodeEvent(prefix+ev.Topic(), api.EventThingUpdated, []api.Thing{
+			apiThing(ev.Thing),
+			apiThing(ev.OldThing),
+		})
+
 	default:
 		panic(fmt.Sprintf("event type %d not (yet) handled", e.Type()))
 	}
 }
+
+// encodeEvent returns the topic and the raw event string from the event payload
+func encodeEvent(topic, eventType string, payload any) (string, string) {
+	rawPayload, err := json.Marshal(payload)
+	if err != nil {
+		panic(err)
+	}
+	rawEvent, err := json.Marshal(api.EventMessage{
+		Topic:   topic,
+		Payload: string(rawPayload),
+		Type:    eventType,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return topic, string(rawEvent)
+}
+
+func apiItem(item event.Item) api.Item {
+	return api.Item{
+		Name:       item.Name,
+		Label:      item.Label,
+		Type:       item.Type,
+		Category:   item.Category,
+		Tags:       item.Tags,
+		GroupNames: item.GroupNames,
+		GroupType:  item.GroupType,
+	}
+}
+
+func apiThing(thing event.Thing) api.Thing {
+	return api.Thing{
+		UID:           thing.UID,
+		Label:         thing.Label,
+		BridgeUID:     thing.BridgeUID,
+		Configuration: thing.Configuration,
+		Properties:    thing.Properties,
+		ThingTypeUID:  thing.ThingTypeUID,
+	}
+}
+
+func apiDiscoveryResult(result event.DiscoveryResult) api.DiscoveryResult {
+	return api.DiscoveryResult{
+		ThingUID:               result.ThingUID,
+		ThingTypeUID:           result.ThingTypeUID,
+		BridgeUID:              result.BridgeUID,
+		Flag:                   result.Flag,
+		Label:                  result.Label,
+		Properties:             result.Properties,
+		RepresentationProperty: result.RepresentationProperty,
+	}
+}
+
+func apiLink(link event.Link) api.ItemChannelLink {
+	return api.ItemChannelLink{
+		ItemName:      link.ItemName,
+		ChannelUID:    link.ChannelUID,
+		Configuration: link.Configuration,
+	}
+}
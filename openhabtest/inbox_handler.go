@@ -0,0 +1,206 @@
+package openhabtest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+)
+
+type inboxHandler struct {
+	log           Logger
+	results       map[string]api.DiscoveryResult
+	resultsLocker sync.Mutex
+	thingsHandler *thingsHandler
+	eventBus      *eventBus
+	version       Version
+	err           error
+}
+
+func newInboxHandler(log Logger, things *thingsHandler, bus *eventBus, version Version) *inboxHandler {
+	return &inboxHandler{
+		log:           log,
+		results:       make(map[string]api.DiscoveryResult, 10),
+		thingsHandler: things,
+		eventBus:      bus,
+		version:       version,
+	}
+}
+
+func (h *inboxHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	encoder := json.NewEncoder(resp)
+
+	if len(parts) == 2 && req.Method == http.MethodGet {
+		// request is: list inbox
+		h.sendInbox(req.URL.Query().Get("includeIgnored") == "true", encoder, resp)
+		return
+	}
+
+	if len(parts) == 3 && req.Method == http.MethodDelete {
+		// request is: remove discovery result
+		h.removeResultFromAPI(parts[2], resp)
+		return
+	}
+
+	if len(parts) == 4 && req.Method == http.MethodPost {
+		switch parts[3] {
+		case "approve":
+			h.approve(parts[2], resp, req)
+			return
+		case "ignore":
+			h.setFlag(parts[2], api.DiscoveryFlagIgnored, resp)
+			return
+		case "unignore":
+			h.setFlag(parts[2], api.DiscoveryFlagNew, resp)
+			return
+		}
+	}
+
+	// fallback
+	resp.WriteHeader(http.StatusNotFound)
+}
+
+func (h *inboxHandler) sendInbox(includeIgnored bool, encoder *json.Encoder, resp http.ResponseWriter) {
+	data := h.getResults(includeIgnored)
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *inboxHandler) approve(thingUID string, resp http.ResponseWriter, req *http.Request) {
+	result, ok := h.getResult(thingUID)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	label, err := io.ReadAll(req.Body)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	thing := api.Thing{
+		UID:           result.ThingUID,
+		Label:         result.Label,
+		BridgeUID:     result.BridgeUID,
+		ThingTypeUID:  result.ThingTypeUID,
+		Configuration: result.Properties,
+		StatusInfo:    api.ThingStatusInfo{Status: "INITIALIZING", StatusDetail: "NONE"},
+	}
+	if len(label) > 0 {
+		thing.Label = string(label)
+	}
+	h.err = errors.Join(h.err, h.thingsHandler.setThing(thing))
+	h.deleteResult(thingUID)
+
+	if h.eventBus == nil {
+		return
+	}
+	// now send the events to the bus
+	h.publish(event.NewInboxRemoved(eventDiscoveryResult(result)))
+	h.publish(event.NewThingAdded(eventThing(thing)))
+}
+
+func (h *inboxHandler) setFlag(thingUID, flag string, resp http.ResponseWriter) {
+	result, ok := h.getResult(thingUID)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	result.Flag = flag
+	h.err = errors.Join(h.err, h.setResult(result))
+
+	if h.eventBus == nil {
+		return
+	}
+	h.publish(event.NewInboxUpdated(eventDiscoveryResult(result)))
+}
+
+func (h *inboxHandler) removeResultFromAPI(thingUID string, resp http.ResponseWriter) {
+	result, ok := h.getResult(thingUID)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	h.deleteResult(thingUID)
+
+	if h.eventBus == nil {
+		return
+	}
+	h.publish(event.NewInboxRemoved(eventDiscoveryResult(result)))
+}
+
+func (h *inboxHandler) publish(e event.Event) {
+	topic, ev := EventString(e, topicPrefix(h.version))
+	h.eventBus.Publish(topic, ev)
+}
+
+// setResult adds the new discovery result, or replaces the existing one (with the same thing UID)
+func (h *inboxHandler) setResult(result api.DiscoveryResult) error {
+	if result.ThingUID == "" {
+		return errors.New("missing thing UID")
+	}
+	h.resultsLocker.Lock()
+	defer h.resultsLocker.Unlock()
+
+	if result.Flag == "" {
+		result.Flag = api.DiscoveryFlagNew
+	}
+	if result.Properties == nil {
+		result.Properties = map[string]any{}
+	}
+	h.results[result.ThingUID] = result
+	return nil
+}
+
+func (h *inboxHandler) deleteResult(thingUID string) {
+	h.resultsLocker.Lock()
+	defer h.resultsLocker.Unlock()
+
+	delete(h.results, thingUID)
+}
+
+func (h *inboxHandler) getResults(includeIgnored bool) []api.DiscoveryResult {
+	h.resultsLocker.Lock()
+	defer h.resultsLocker.Unlock()
+
+	all := make([]api.DiscoveryResult, 0, len(h.results))
+	for _, result := range h.results {
+		if result.Flag == api.DiscoveryFlagIgnored && !includeIgnored {
+			continue
+		}
+		all = append(all, result)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ThingUID < all[j].ThingUID
+	})
+	return all
+}
+
+func (h *inboxHandler) getResult(thingUID string) (api.DiscoveryResult, bool) {
+	h.resultsLocker.Lock()
+	defer h.resultsLocker.Unlock()
+
+	result, ok := h.results[thingUID]
+	return result, ok
+}
+
+func eventDiscoveryResult(result api.DiscoveryResult) event.DiscoveryResult {
+	return event.DiscoveryResult{
+		ThingUID:               result.ThingUID,
+		ThingTypeUID:           result.ThingTypeUID,
+		BridgeUID:              result.BridgeUID,
+		Flag:                   result.Flag,
+		Label:                  result.Label,
+		Properties:             result.Properties,
+		RepresentationProperty: result.RepresentationProperty,
+	}
+}
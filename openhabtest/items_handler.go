@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -36,14 +37,20 @@ func (h *itemsHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 
 	if len(parts) == 2 && req.Method == http.MethodGet {
 		// request is: get all items
-		h.sendAllItems(encoder, resp)
+		h.sendAllItems(encoder, resp, req)
+		return
+	}
+
+	if len(parts) == 2 && req.Method == http.MethodPut {
+		// request is: add or update a list of items
+		h.receiveItems(encoder, resp, req)
 		return
 	}
 
 	if len(parts) == 3 {
 		if req.Method == http.MethodGet {
 			// request is: get single item
-			h.sendItem(parts[2], encoder, resp)
+			h.sendItem(parts[2], encoder, resp, req)
 			return
 		}
 
@@ -52,6 +59,18 @@ func (h *itemsHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 			h.receiveCommand(parts[2], encoder, resp, req)
 			return
 		}
+
+		if req.Method == http.MethodPut {
+			// request is: add or update item
+			h.receiveItem(parts[2], encoder, resp, req)
+			return
+		}
+
+		if req.Method == http.MethodDelete {
+			// request is: remove item
+			h.deleteItem(parts[2], resp)
+			return
+		}
 	}
 
 	if len(parts) == 4 && parts[3] == "state" {
@@ -68,12 +87,30 @@ func (h *itemsHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 		}
 	}
 
+	if len(parts) == 5 && parts[3] == "metadata" {
+		if req.Method == http.MethodPut {
+			// request is: add or update item metadata
+			h.receiveMetadata(parts[2], parts[4], resp, req)
+			return
+		}
+
+		if req.Method == http.MethodDelete {
+			// request is: remove item metadata
+			h.deleteMetadata(parts[2], parts[4], resp)
+			return
+		}
+	}
+
 	// fallback
 	resp.WriteHeader(http.StatusNotFound)
 }
 
-func (h *itemsHandler) sendAllItems(encoder *json.Encoder, resp http.ResponseWriter) {
-	data := h.getItems()
+func (h *itemsHandler) sendAllItems(encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	data := filterItems(h.getItems(), query.Get("type"), query.Get("tags"))
+	for i := range data {
+		data[i].Metadata = filterMetadata(data[i].Metadata, query.Get("metadata"))
+	}
 	err := encoder.Encode(&data)
 	if err != nil {
 		h.log.Logf("cannot encode data into JSON: %+v", data)
@@ -81,9 +118,13 @@ func (h *itemsHandler) sendAllItems(encoder *json.Encoder, resp http.ResponseWri
 	}
 }
 
-func (h *itemsHandler) sendItem(name string, encoder *json.Encoder, resp http.ResponseWriter) {
+func (h *itemsHandler) sendItem(name string, encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
 	data, ok := h.getItem(name)
 	if ok {
+		data.Metadata = filterMetadata(data.Metadata, req.URL.Query().Get("metadata"))
+		if req.URL.Query().Get("recursive") == "true" {
+			data.Members = h.getMembers(name, req.URL.Query().Get("metadata"), map[string]bool{name: true})
+		}
 		err := encoder.Encode(&data)
 		if err != nil {
 			h.log.Logf("cannot encode data into JSON: %+v", data)
@@ -106,7 +147,7 @@ func (h *itemsHandler) receiveCommand(name string, _ *json.Encoder, resp http.Re
 		oldState := item.State
 		newState := string(state)
 		item.State = newState
-		h.err = errors.Join(err, h.setItem(item))
+		h.err = errors.Join(h.err, h.setItem(item))
 		resp.WriteHeader(http.StatusOK)
 
 		if h.eventBus == nil {
@@ -168,6 +209,133 @@ func (h *itemsHandler) receiveState(name string, _ *json.Encoder, resp http.Resp
 	resp.WriteHeader(http.StatusNotFound)
 }
 
+func (h *itemsHandler) receiveItem(name string, encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	item := api.Item{}
+	err := json.NewDecoder(req.Body).Decode(&item)
+	if err != nil || (item.Name != "" && item.Name != name) || (item.Type == "" && item.GroupType == "") {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	item.Name = name
+	saved, created := h.saveItem(item)
+	if created {
+		resp.WriteHeader(http.StatusCreated)
+	}
+	h.err = errors.Join(h.err, encoder.Encode(&saved))
+}
+
+func (h *itemsHandler) receiveItems(encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	items := make([]api.Item, 0)
+	err := json.NewDecoder(req.Body).Decode(&items)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	results := make([]api.BulkItemResult, len(items))
+	for i, item := range items {
+		results[i].Name = item.Name
+		if item.Name == "" || (item.Type == "" && item.GroupType == "") {
+			results[i].Status = api.BulkItemError
+			results[i].Message = "invalid item definition"
+			continue
+		}
+		_, created := h.saveItem(item)
+		results[i].Status = api.BulkItemUpdated
+		if created {
+			results[i].Status = api.BulkItemCreated
+		}
+	}
+	h.err = errors.Join(h.err, encoder.Encode(&results))
+}
+
+// saveItem adds or updates the item definition, keeping the state and metadata of an existing item.
+// It sends the corresponding item registry event.
+func (h *itemsHandler) saveItem(item api.Item) (api.Item, bool) {
+	previous, exists := h.getItem(item.Name)
+	if exists {
+		item.State = previous.State
+		item.Metadata = previous.Metadata
+	} else {
+		item.State = "NULL"
+		item.Metadata = nil
+	}
+	h.err = errors.Join(h.err, h.setItem(item))
+	saved, _ := h.getItem(item.Name)
+
+	if h.eventBus != nil {
+		var topic, ev string
+		if exists {
+			topic, ev = EventString(event.NewItemUpdated(eventItem(previous), eventItem(saved)), topicPrefix(h.version))
+		} else {
+			topic, ev = EventString(event.NewItemAdded(eventItem(saved)), topicPrefix(h.version))
+		}
+		h.eventBus.Publish(topic, ev)
+	}
+	return saved, !exists
+}
+
+func (h *itemsHandler) deleteItem(name string, resp http.ResponseWriter) {
+	item, ok := h.getItem(name)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	h.err = errors.Join(h.err, h.removeItem(name))
+	resp.WriteHeader(http.StatusOK)
+
+	if h.eventBus == nil {
+		return
+	}
+	topic, ev := EventString(event.NewItemRemoved(eventItem(item)), topicPrefix(h.version))
+	h.eventBus.Publish(topic, ev)
+}
+
+func (h *itemsHandler) receiveMetadata(name, namespace string, resp http.ResponseWriter, req *http.Request) {
+	metadata := api.Metadata{}
+	err := json.NewDecoder(req.Body).Decode(&metadata)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.itemsLocker.Lock()
+	defer h.itemsLocker.Unlock()
+
+	item, ok := h.items[name]
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, exists := item.Metadata[namespace]
+	item.Metadata = copyMetadata(item.Metadata)
+	item.Metadata[namespace] = metadata
+	h.items[name] = item
+	if exists {
+		resp.WriteHeader(http.StatusOK)
+		return
+	}
+	resp.WriteHeader(http.StatusCreated)
+}
+
+func (h *itemsHandler) deleteMetadata(name, namespace string, resp http.ResponseWriter) {
+	h.itemsLocker.Lock()
+	defer h.itemsLocker.Unlock()
+
+	item, ok := h.items[name]
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if _, exists := item.Metadata[namespace]; !exists {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	item.Metadata = copyMetadata(item.Metadata)
+	delete(item.Metadata, namespace)
+	h.items[name] = item
+	resp.WriteHeader(http.StatusOK)
+}
+
 // setItem adds the new item, or replaces the existing one (with the same name)
 func (h *itemsHandler) setItem(item api.Item) error {
 	if item.Name == "" {
@@ -183,6 +351,9 @@ func (h *itemsHandler) setItem(item api.Item) error {
 	if item.GroupNames == nil {
 		item.GroupNames = []string{}
 	}
+	if item.Metadata != nil {
+		item.Metadata = copyMetadata(item.Metadata)
+	}
 	h.items[item.Name] = item
 	return nil
 }
@@ -219,3 +390,106 @@ func (h *itemsHandler) getItem(name string) (api.Item, bool) {
 	item, ok := h.items[name]
 	return item, ok
 }
+
+// getMembers returns the members of the group, with the members of the sub-groups.
+// The groups already visited are not expanded again to protect against cycles.
+func (h *itemsHandler) getMembers(groupName, metadata string, visited map[string]bool) []*api.Item {
+	members := make([]*api.Item, 0)
+	for _, item := range h.getItems() {
+		if !contains(item.GroupNames, groupName) {
+			continue
+		}
+		member := item
+		member.Metadata = filterMetadata(member.Metadata, metadata)
+		if member.Type == "Group" && !visited[member.Name] {
+			visited[member.Name] = true
+			member.Members = h.getMembers(member.Name, metadata, visited)
+		}
+		members = append(members, &member)
+	}
+	return members
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// filterItems only keeps the items of this type (if not empty) and having all the tags (comma separated list)
+func filterItems(items []api.Item, itemType, tags string) []api.Item {
+	if itemType == "" && tags == "" {
+		return items
+	}
+	filtered := make([]api.Item, 0, len(items))
+	for _, item := range items {
+		if itemType != "" && item.Type != itemType {
+			continue
+		}
+		if tags != "" && !hasAllTags(item.Tags, strings.Split(tags, ",")) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+	return filtered
+}
+
+func hasAllTags(itemTags, tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, itemTag := range itemTags {
+			if strings.EqualFold(itemTag, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// filterMetadata only keeps the namespaces matching the "metadata" query parameter,
+// which is a comma separated list of regular expressions
+func filterMetadata(metadata map[string]api.Metadata, selector string) map[string]api.Metadata {
+	if selector == "" || len(metadata) == 0 {
+		return nil
+	}
+	filtered := make(map[string]api.Metadata, len(metadata))
+	for _, expression := range strings.Split(selector, ",") {
+		pattern, err := regexp.Compile("^(" + strings.TrimSpace(expression) + ")$")
+		if err != nil {
+			continue
+		}
+		for namespace, value := range metadata {
+			if pattern.MatchString(namespace) {
+				filtered[namespace] = value
+			}
+		}
+	}
+	return filtered
+}
+
+func copyMetadata(metadata map[string]api.Metadata) map[string]api.Metadata {
+	copied := make(map[string]api.Metadata, len(metadata)+1)
+	for namespace, value := range metadata {
+		copied[namespace] = value
+	}
+	return copied
+}
+
+func eventItem(item api.Item) event.Item {
+	return event.Item{
+		Name:       item.Name,
+		Label:      item.Label,
+		Type:       item.Type,
+		Category:   item.Category,
+		Tags:       item.Tags,
+		GroupNames: item.GroupNames,
+		GroupType:  item.GroupType,
+	}
+}
@@ -0,0 +1,207 @@
+package openhabtest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+)
+
+type linksHandler struct {
+	log         Logger
+	links       map[string]api.ItemChannelLink
+	linksLocker sync.Mutex
+	eventBus    *eventBus
+	version     Version
+	err         error
+}
+
+func newLinksHandler(log Logger, bus *eventBus, version Version) *linksHandler {
+	return &linksHandler{
+		log:      log,
+		links:    make(map[string]api.ItemChannelLink, 10),
+		eventBus: bus,
+		version:  version,
+	}
+}
+
+func (h *linksHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	encoder := json.NewEncoder(resp)
+
+	if len(parts) == 2 && req.Method == http.MethodGet {
+		// request is: get all links
+		query := req.URL.Query()
+		h.sendLinks(query.Get("itemName"), query.Get("channelUID"), encoder, resp)
+		return
+	}
+
+	if len(parts) == 4 {
+		switch req.Method {
+		case http.MethodGet:
+			// request is: get single link
+			h.sendLink(parts[2], parts[3], encoder, resp)
+			return
+		case http.MethodPut:
+			// request is: create or update link
+			h.receiveLink(parts[2], parts[3], resp, req)
+			return
+		case http.MethodDelete:
+			// request is: remove link
+			h.deleteLink(parts[2], parts[3], resp)
+			return
+		}
+	}
+
+	// fallback
+	resp.WriteHeader(http.StatusNotFound)
+}
+
+func (h *linksHandler) sendLinks(itemName, channelUID string, encoder *json.Encoder, resp http.ResponseWriter) {
+	data := h.getLinks(itemName, channelUID)
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *linksHandler) sendLink(itemName, channelUID string, encoder *json.Encoder, resp http.ResponseWriter) {
+	data, ok := h.getLink(itemName, channelUID)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *linksHandler) receiveLink(itemName, channelUID string, resp http.ResponseWriter, req *http.Request) {
+	existing, found := h.getLink(itemName, channelUID)
+	if found && !existing.Editable {
+		resp.WriteHeader(http.StatusConflict)
+		return
+	}
+	link := api.ItemChannelLink{}
+	err := json.NewDecoder(req.Body).Decode(&link)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if (link.ItemName != "" && link.ItemName != itemName) || (link.ChannelUID != "" && link.ChannelUID != channelUID) {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	link.ItemName = itemName
+	link.ChannelUID = channelUID
+	h.err = errors.Join(h.err, h.setLink(link))
+
+	if h.eventBus == nil || found {
+		return
+	}
+	// now send the event to the bus
+	topic, ev := EventString(event.NewItemChannelLinkAdded(eventLink(link)), topicPrefix(h.version))
+	h.eventBus.Publish(topic, ev)
+}
+
+func (h *linksHandler) deleteLink(itemName, channelUID string, resp http.ResponseWriter) {
+	link, found := h.getLink(itemName, channelUID)
+	if !found {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if !link.Editable {
+		resp.WriteHeader(http.StatusConflict)
+		return
+	}
+	h.err = errors.Join(h.err, h.removeLink(itemName, channelUID))
+
+	if h.eventBus == nil {
+		return
+	}
+	// now send the event to the bus
+	topic, ev := EventString(event.NewItemChannelLinkRemoved(eventLink(link)), topicPrefix(h.version))
+	h.eventBus.Publish(topic, ev)
+}
+
+// setLink adds the new link, or replaces the existing one (with the same item name and channel UID)
+func (h *linksHandler) setLink(link api.ItemChannelLink) error {
+	if link.ItemName == "" {
+		return errors.New("missing item name")
+	}
+	if link.ChannelUID == "" {
+		return errors.New("missing channel UID")
+	}
+	h.linksLocker.Lock()
+	defer h.linksLocker.Unlock()
+
+	if link.Configuration == nil {
+		link.Configuration = map[string]any{}
+	}
+	link.Editable = true
+	h.links[linkName(link.ItemName, link.ChannelUID)] = link
+	return nil
+}
+
+// removeLink removes an existing link. It doesn't return an error if the link doesn't exist.
+func (h *linksHandler) removeLink(itemName, channelUID string) error {
+	if itemName == "" {
+		return errors.New("missing item name")
+	}
+	if channelUID == "" {
+		return errors.New("missing channel UID")
+	}
+	h.linksLocker.Lock()
+	defer h.linksLocker.Unlock()
+
+	delete(h.links, linkName(itemName, channelUID))
+	return nil
+}
+
+func (h *linksHandler) getLinks(itemName, channelUID string) []api.ItemChannelLink {
+	h.linksLocker.Lock()
+	defer h.linksLocker.Unlock()
+
+	all := make([]api.ItemChannelLink, 0, len(h.links))
+	for _, link := range h.links {
+		if itemName != "" && link.ItemName != itemName {
+			continue
+		}
+		if channelUID != "" && link.ChannelUID != channelUID {
+			continue
+		}
+		all = append(all, link)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return linkName(all[i].ItemName, all[i].ChannelUID) < linkName(all[j].ItemName, all[j].ChannelUID)
+	})
+	return all
+}
+
+func (h *linksHandler) getLink(itemName, channelUID string) (api.ItemChannelLink, bool) {
+	h.linksLocker.Lock()
+	defer h.linksLocker.Unlock()
+
+	link, ok := h.links[linkName(itemName, channelUID)]
+	return link, ok
+}
+
+func linkName(itemName, channelUID string) string {
+	return itemName + "-" + channelUID
+}
+
+func eventLink(link api.ItemChannelLink) event.Link {
+	return event.Link{
+		ItemName:      link.ItemName,
+		ChannelUID:    link.ChannelUID,
+		Configuration: link.Configuration,
+	}
+}
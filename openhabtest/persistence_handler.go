@@ -0,0 +1,308 @@
+package openhabtest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+// persistenceTimeFormats are the formats accepted for the time parameters
+var persistenceTimeFormats = []string{
+	"2006-01-02T15:04:05.000-0700",
+	time.RFC3339Nano,
+}
+
+type persistenceHandler struct {
+	log        Logger
+	services   []api.PersistenceService
+	data       map[string]map[string][]api.HistoryDataBean // by service ID then item name
+	dataLocker sync.Mutex
+	err        error
+}
+
+func newPersistenceHandler(log Logger) *persistenceHandler {
+	return &persistenceHandler{
+		log:      log,
+		services: make([]api.PersistenceService, 0),
+		data:     make(map[string]map[string][]api.HistoryDataBean),
+	}
+}
+
+func (h *persistenceHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	encoder := json.NewEncoder(resp)
+
+	if len(parts) == 2 && req.Method == http.MethodGet {
+		// request is: get all persistence services
+		h.sendServices(encoder, resp)
+		return
+	}
+
+	if len(parts) == 4 && parts[2] == "items" {
+		switch req.Method {
+		case http.MethodGet:
+			// request is: get item history
+			h.sendHistory(parts[3], encoder, resp, req)
+			return
+		case http.MethodPut:
+			// request is: store item state
+			h.receiveState(parts[3], resp, req)
+			return
+		case http.MethodDelete:
+			// request is: delete item history
+			h.deleteHistory(parts[3], resp, req)
+			return
+		}
+	}
+
+	// fallback
+	resp.WriteHeader(http.StatusNotFound)
+}
+
+func (h *persistenceHandler) sendServices(encoder *json.Encoder, resp http.ResponseWriter) {
+	h.dataLocker.Lock()
+	data := append([]api.PersistenceService{}, h.services...)
+	h.dataLocker.Unlock()
+
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *persistenceHandler) sendHistory(itemName string, encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	serviceID, ok := h.getServiceID(query.Get("serviceId"))
+	if !ok {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	end, err := parsePersistenceTime(query.Get("endtime"), time.Now())
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	start, err := parsePersistenceTime(query.Get("starttime"), end.Add(-24*time.Hour))
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	points := h.getHistory(serviceID, itemName, start, end, query.Get("boundary") == "true")
+	data := api.ItemHistory{
+		Name:         itemName,
+		TotalRecords: strconv.Itoa(len(points)),
+		DataPoints:   strconv.Itoa(len(points)),
+		Data:         points,
+	}
+	err = encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *persistenceHandler) receiveState(itemName string, resp http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	serviceID, ok := h.getServiceID(query.Get("serviceId"))
+	if !ok || !h.isModifiable(serviceID) {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	at, err := parsePersistenceTime(query.Get("time"), time.Time{})
+	if err != nil || at.IsZero() || query.Get("state") == "" {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	h.err = errors.Join(h.err, h.addHistory(serviceID, itemName, []api.HistoryDataBean{
+		{Time: at.UnixMilli(), State: query.Get("state")},
+	}))
+}
+
+func (h *persistenceHandler) deleteHistory(itemName string, resp http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	serviceID := query.Get("serviceId")
+	// the service is mandatory to delete data
+	if serviceID == "" {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if _, ok := h.getServiceID(serviceID); !ok || !h.isModifiable(serviceID) {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	start, err := parsePersistenceTime(query.Get("starttime"), time.Time{})
+	if err != nil || start.IsZero() {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	end, err := parsePersistenceTime(query.Get("endtime"), time.Time{})
+	if err != nil || end.IsZero() {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	h.removeHistory(serviceID, itemName, start, end)
+}
+
+// isModifiable returns true if the data of the persistence service can be stored or deleted
+func (h *persistenceHandler) isModifiable(serviceID string) bool {
+	h.dataLocker.Lock()
+	defer h.dataLocker.Unlock()
+
+	for _, service := range h.services {
+		if service.ID == serviceID {
+			return service.Type == api.PersistenceServiceModifiable
+		}
+	}
+	return false
+}
+
+// getServiceID returns the service ID if it exists, or the default (first) service ID if empty
+func (h *persistenceHandler) getServiceID(serviceID string) (string, bool) {
+	h.dataLocker.Lock()
+	defer h.dataLocker.Unlock()
+
+	if len(h.services) == 0 {
+		return "", false
+	}
+	if serviceID == "" {
+		return h.services[0].ID, true
+	}
+	_, ok := h.data[serviceID]
+	return serviceID, ok
+}
+
+// getHistory returns the data points of the item between start and end (included), sorted by time.
+// With boundary, the data points just before start and just after end are also returned:
+// like openHAB, their time is set to start and end.
+func (h *persistenceHandler) getHistory(serviceID, itemName string, start, end time.Time, boundary bool) []api.HistoryDataBean {
+	h.dataLocker.Lock()
+	defer h.dataLocker.Unlock()
+
+	points := make([]api.HistoryDataBean, 0)
+	var before []api.HistoryDataBean
+	for _, point := range h.data[serviceID][itemName] {
+		if point.Time < start.UnixMilli() {
+			point.Time = start.UnixMilli()
+			before = []api.HistoryDataBean{point}
+			continue
+		}
+		if point.Time > end.UnixMilli() {
+			if boundary {
+				point.Time = end.UnixMilli()
+				points = append(points, point)
+			}
+			break
+		}
+		points = append(points, point)
+	}
+	if boundary {
+		points = append(before, points...)
+	}
+	return points
+}
+
+// setService adds the persistence service, or replaces the existing one (with the same ID)
+func (h *persistenceHandler) setService(service api.PersistenceService) error {
+	if service.ID == "" {
+		return errors.New("missing persistence service ID")
+	}
+	if service.Type == "" {
+		service.Type = api.PersistenceServiceModifiable
+	}
+	h.dataLocker.Lock()
+	defer h.dataLocker.Unlock()
+
+	if _, ok := h.data[service.ID]; !ok {
+		h.data[service.ID] = make(map[string][]api.HistoryDataBean)
+		h.services = append(h.services, service)
+		return nil
+	}
+	for i := range h.services {
+		if h.services[i].ID == service.ID {
+			h.services[i] = service
+		}
+	}
+	return nil
+}
+
+// addHistory adds the data points of the item to the persistence service
+func (h *persistenceHandler) addHistory(serviceID, itemName string, points []api.HistoryDataBean) error {
+	if itemName == "" {
+		return errors.New("missing item name")
+	}
+	h.dataLocker.Lock()
+	defer h.dataLocker.Unlock()
+
+	service, ok := h.data[serviceID]
+	if !ok {
+		return errors.New("unknown persistence service " + strconv.Quote(serviceID))
+	}
+	history := service[itemName]
+	for _, point := range points {
+		point.State = persistedState(point.State)
+		// a new state at the same time replaces the existing one
+		index := sort.Search(len(history), func(i int) bool {
+			return history[i].Time >= point.Time
+		})
+		if index < len(history) && history[index].Time == point.Time {
+			history[index] = point
+			continue
+		}
+		history = append(history, api.HistoryDataBean{})
+		copy(history[index+1:], history[index:])
+		history[index] = point
+	}
+	service[itemName] = history
+	return nil
+}
+
+// persistedState returns the state as saved by openHAB: the unit symbol of a quantity is not persisted
+func persistedState(state string) string {
+	value, symbol, found := strings.Cut(state, " ")
+	if !found || symbol == "" {
+		return state
+	}
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return state
+	}
+	return value
+}
+
+// removeHistory removes the data points of the item between start and end (included)
+func (h *persistenceHandler) removeHistory(serviceID, itemName string, start, end time.Time) {
+	h.dataLocker.Lock()
+	defer h.dataLocker.Unlock()
+
+	history := h.data[serviceID][itemName]
+	kept := make([]api.HistoryDataBean, 0, len(history))
+	for _, point := range history {
+		if point.Time >= start.UnixMilli() && point.Time <= end.UnixMilli() {
+			continue
+		}
+		kept = append(kept, point)
+	}
+	h.data[serviceID][itemName] = kept
+}
+
+func parsePersistenceTime(value string, defaultTime time.Time) (time.Time, error) {
+	if value == "" {
+		return defaultTime, nil
+	}
+	var err error
+	for _, format := range persistenceTimeFormats {
+		var parsed time.Time
+		parsed, err = time.Parse(format, value)
+		if err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, err
+}
@@ -10,15 +10,21 @@ import (
 
 // Server is a mock openHAB instance to use in tests.
 type Server struct {
-	log           Logger
-	version       Version
-	server        *httptest.Server
-	eventBus      *eventBus
-	closeLocker   sync.Mutex
-	itemsHandler  *itemsHandler
-	done          chan bool
-	closed        bool
-	eventsHandler *eventsHandler
+	log                Logger
+	version            Version
+	server             *httptest.Server
+	eventBus           *eventBus
+	closeLocker        sync.Mutex
+	itemsHandler       *itemsHandler
+	thingsHandler      *thingsHandler
+	actionsHandler     *actionsHandler
+	inboxHandler       *inboxHandler
+	linksHandler       *linksHandler
+	persistenceHandler *persistenceHandler
+	sitemapsHandler    *sitemapsHandler
+	done               chan bool
+	closed             bool
+	eventsHandler      *eventsHandler
 }
 
 // NewServer creates a new mock openHAB instance to use in tests
@@ -35,20 +41,38 @@ func NewServer(config Config) *Server {
 	}
 	eventsHandler := newEventsHandler(bus, done)
 	itemsHandler := newItemsHandler(config.Log, autoBus, config.Version)
+	thingsHandler := newThingsHandler(config.Log, autoBus, config.Version)
+	actionsHandler := newActionsHandler(config.Log)
+	inboxHandler := newInboxHandler(config.Log, thingsHandler, autoBus, config.Version)
+	linksHandler := newLinksHandler(config.Log, autoBus, config.Version)
+	persistenceHandler := newPersistenceHandler(config.Log)
+	sitemapsHandler := newSitemapsHandler(config.Log, done)
 	routes := []route{
 		{"events", eventsHandler},
 		{"items", itemsHandler},
+		{"things", thingsHandler},
+		{"actions", actionsHandler},
+		{"inbox", inboxHandler},
+		{"links", linksHandler},
+		{"persistence", persistenceHandler},
+		{"sitemaps", sitemapsHandler},
 	}
 
 	server := httptest.NewServer(newRootHandler(config.Log, routes, config.Version))
 	return &Server{
-		log:           config.Log,
-		version:       config.Version,
-		server:        server,
-		eventBus:      bus,
-		itemsHandler:  itemsHandler,
-		done:          done,
-		eventsHandler: eventsHandler,
+		log:                config.Log,
+		version:            config.Version,
+		server:             server,
+		eventBus:           bus,
+		itemsHandler:       itemsHandler,
+		thingsHandler:      thingsHandler,
+		actionsHandler:     actionsHandler,
+		inboxHandler:       inboxHandler,
+		linksHandler:       linksHandler,
+		persistenceHandler: persistenceHandler,
+		sitemapsHandler:    sitemapsHandler,
+		done:               done,
+		eventsHandler:      eventsHandler,
 	}
 }
 
@@ -75,6 +99,48 @@ func (s *Server) ItemsErr() error {
 	return s.itemsHandler.err
 }
 
+// ThingsErr returns an error if any happened from the thing endpoints.
+//
+// A non-nil error returned by ThingsErr implements the Unwrap() []error method.
+func (s *Server) ThingsErr() error {
+	return s.thingsHandler.err
+}
+
+// ActionsErr returns an error if any happened from the action endpoints.
+//
+// A non-nil error returned by ActionsErr implements the Unwrap() []error method.
+func (s *Server) ActionsErr() error {
+	return s.actionsHandler.err
+}
+
+// InboxErr returns an error if any happened from the inbox endpoints.
+//
+// A non-nil error returned by InboxErr implements the Unwrap() []error method.
+func (s *Server) InboxErr() error {
+	return s.inboxHandler.err
+}
+
+// LinksErr returns an error if any happened from the link endpoints.
+//
+// A non-nil error returned by LinksErr implements the Unwrap() []error method.
+func (s *Server) LinksErr() error {
+	return s.linksHandler.err
+}
+
+// PersistenceErr returns an error if any happened from the persistence endpoints.
+//
+// A non-nil error returned by PersistenceErr implements the Unwrap() []error method.
+func (s *Server) PersistenceErr() error {
+	return s.persistenceHandler.err
+}
+
+// SitemapsErr returns an error if any happened from the sitemap endpoints.
+//
+// A non-nil error returned by SitemapsErr implements the Unwrap() []error method.
+func (s *Server) SitemapsErr() error {
+	return s.sitemapsHandler.err
+}
+
 // Close the mock openHAB server. The call will also close any long running request to the event bus API.
 // The method can safely be called multiple times.
 func (s *Server) Close() {
@@ -128,3 +194,66 @@ func (s *Server) SetItem(item api.Item) error {
 func (s *Server) RemoveItem(itemName string) error {
 	return s.itemsHandler.removeItem(itemName)
 }
+
+// SetThing adds the new thing, or replaces the existing one (with the same UID).
+func (s *Server) SetThing(thing api.Thing) error {
+	return s.thingsHandler.setThing(thing)
+}
+
+// RemoveThing removes an existing thing. It doesn't return an error if the thing doesn't exist.
+func (s *Server) RemoveThing(uid string) error {
+	return s.thingsHandler.removeThing(uid)
+}
+
+// SetThingAction adds an action to a thing, or replaces the existing one (with the same action UID).
+// The handler is called when the action is invoked; a nil handler returns no output.
+func (s *Server) SetThingAction(thingUID string, action api.ThingAction, handler ActionHandler) error {
+	return s.actionsHandler.setAction(thingUID, action, handler)
+}
+
+// RemoveThingActions removes all the actions of a thing. It doesn't return an error if the thing has no action.
+func (s *Server) RemoveThingActions(thingUID string) error {
+	return s.actionsHandler.removeActions(thingUID)
+}
+
+// SetInboxResult adds the new discovery result to the inbox, or replaces the existing one (with the same thing UID).
+// The Flag property defaults to NEW.
+func (s *Server) SetInboxResult(result api.DiscoveryResult) error {
+	return s.inboxHandler.setResult(result)
+}
+
+// SetLink adds the new link between an item and a channel, or replaces the existing one.
+func (s *Server) SetLink(link api.ItemChannelLink) error {
+	return s.linksHandler.setLink(link)
+}
+
+// RemoveLink removes an existing link. It doesn't return an error if the link doesn't exist.
+func (s *Server) RemoveLink(itemName, channelUID string) error {
+	return s.linksHandler.removeLink(itemName, channelUID)
+}
+
+// SetPersistenceService adds the persistence service, or replaces the existing one (with the same ID).
+// The first service added is the default service. The Type property defaults to Modifiable.
+func (s *Server) SetPersistenceService(service api.PersistenceService) error {
+	return s.persistenceHandler.setService(service)
+}
+
+// AddItemHistory adds the persisted states of an item to a persistence service added with SetPersistenceService.
+// The Time of each data point is in milliseconds since epoch.
+// Like openHAB, the unit symbol of a quantity (like "20 °C") is not persisted:
+// the value is expected in the unit of the item.
+func (s *Server) AddItemHistory(serviceID, itemName string, points ...api.HistoryDataBean) error {
+	return s.persistenceHandler.addHistory(serviceID, itemName, points)
+}
+
+// SetSitemap adds the new sitemap with its pages, or replaces the existing one (with the same name).
+// The ID of the homepage is the name of the sitemap.
+func (s *Server) SetSitemap(sitemap api.Sitemap, pages ...api.SitemapPage) error {
+	return s.sitemapsHandler.setSitemap(sitemap, pages)
+}
+
+// SitemapEvent sends the event to the clients subscribed to the sitemap page.
+// It returns an error if the sitemap name or the page ID is missing.
+func (s *Server) SitemapEvent(ev api.SitemapEvent) error {
+	return s.sitemapsHandler.publish(ev)
+}
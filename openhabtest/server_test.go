@@ -3,8 +3,10 @@ package openhabtest
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -143,6 +145,22 @@ func TestCanEncodeEvents(t *testing.T) {
 			event.NewItemStateChanged("TestSwitch", "OnOff", "OFF", "OnOff", "ON"),
 			`{"topic":"smarthome/items/TestSwitch/statechanged","payload":"{\"type\":\"OnOff\",\"value\":\"ON\",\"oldType\":\"OnOff\",\"oldValue\":\"OFF\"}","type":"ItemStateChangedEvent"}`,
 		},
+		{
+			event.NewGroupItemStateUpdated("Heating", "Heating_Kitchen", "Decimal", "3"),
+			`{"topic":"smarthome/items/Heating/Heating_Kitchen/stateupdated","payload":"{\"type\":\"Decimal\",\"value\":\"3\"}","type":"GroupStateUpdatedEvent"}`,
+		},
+		{
+			event.NewThingStatusInfoEvent("zwave:device:1:node8", event.ThingStatus{Status: "ONLINE", StatusDetail: "NONE"}),
+			`{"topic":"smarthome/things/zwave:device:1:node8/status","payload":"{\"status\":\"ONLINE\",\"statusDetail\":\"NONE\",\"description\":\"\"}","type":"ThingStatusInfoEvent"}`,
+		},
+		{
+			event.NewChannelTriggered("astro:sun:local:set#event", "START"),
+			`{"topic":"smarthome/channels/astro:sun:local:set#event/triggered","payload":"{\"event\":\"START\",\"channel\":\"astro:sun:local:set#event\"}","type":"ChannelTriggeredEvent"}`,
+		},
+		{
+			event.NewThingAdded(event.Thing{UID: "astro:sun:local", Label: "Sun", ThingTypeUID: "astro:sun"}),
+			`{"topic":"smarthome/things/astro:sun:local/added","payload":"{\"UID\":\"astro:sun:local\",\"label\":\"Sun\",\"statusInfo\":{\"status\":\"\",\"statusDetail\":\"\",\"description\":\"\"},\"bridgeUID\":\"\",\"configuration\":null,\"properties\":null,\"thingTypeUID\":\"astro:sun\",\"editable\":false}","type":"ThingAddedEvent"}`,
+		},
 	}
 	server := NewServer(Config{Log: t})
 	defer server.Close()
@@ -587,3 +605,51 @@ func TestSetItemStateEvents(t *testing.T) {
 	assert.NoError(t, server.EventsErr())
 	assert.NoError(t, server.ItemsErr())
 }
+
+func TestItemMetadataQuery(t *testing.T) {
+	t.Parallel()
+	server := NewServer(Config{Log: t})
+	defer server.Close()
+
+	require.NoError(t, server.SetItem(api.Item{
+		Name: "TestItem",
+		Type: "Switch",
+		Metadata: map[string]api.Metadata{
+			"expire":  {Value: "10m"},
+			"homekit": {Value: "Lighting"},
+			"alexa":   {Value: "Light"},
+		},
+	}))
+
+	fixtures := []struct {
+		query      string
+		namespaces []string
+	}{
+		{"", nil},
+		{"?metadata=.*", []string{"alexa", "expire", "homekit"}},
+		{"?metadata=expire", []string{"expire"}},
+		{"?metadata=expire,home.*", []string{"expire", "homekit"}},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.query, func(t *testing.T) {
+			resp, err := http.Get(server.URL() + "/rest/items/TestItem" + fixture.query)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			item := api.Item{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&item))
+			namespaces := make([]string, 0, len(item.Metadata))
+			for namespace := range item.Metadata {
+				namespaces = append(namespaces, namespace)
+			}
+			sort.Strings(namespaces)
+			if fixture.namespaces == nil {
+				assert.Empty(t, namespaces)
+				return
+			}
+			assert.Equal(t, fixture.namespaces, namespaces)
+		})
+	}
+	assert.NoError(t, server.ItemsErr())
+}
@@ -0,0 +1,211 @@
+package openhabtest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/creativeprojects/gopenhab/api"
+)
+
+var (
+	sitemapStreamPrefix = []byte("event: event\ndata: ")
+	sitemapStreamSuffix = []byte("\n\n")
+)
+
+type sitemapsHandler struct {
+	log           Logger
+	sitemaps      map[string]api.Sitemap
+	pages         map[string]map[string]api.SitemapPage // by sitemap name then page ID
+	subscriptions map[string]bool
+	subID         int
+	sitemapLocker sync.Mutex
+	eventBus      *eventBus
+	done          <-chan bool
+	err           error
+}
+
+func newSitemapsHandler(log Logger, done <-chan bool) *sitemapsHandler {
+	return &sitemapsHandler{
+		log:           log,
+		sitemaps:      make(map[string]api.Sitemap),
+		pages:         make(map[string]map[string]api.SitemapPage),
+		subscriptions: make(map[string]bool),
+		eventBus:      newEventBus(),
+		done:          done,
+	}
+}
+
+func (h *sitemapsHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	encoder := json.NewEncoder(resp)
+
+	if len(parts) == 2 && req.Method == http.MethodGet {
+		// request is: get all sitemaps
+		h.sendSitemaps(encoder, resp)
+		return
+	}
+
+	if len(parts) == 4 && parts[2] == "events" {
+		if parts[3] == "subscribe" && req.Method == http.MethodPost {
+			// request is: create a subscription
+			h.subscribe(encoder, resp, req)
+			return
+		}
+		if req.Method == http.MethodGet {
+			// request is: get the events of a subscription
+			h.sendEvents(parts[3], resp, req)
+			return
+		}
+	}
+
+	if len(parts) == 4 && req.Method == http.MethodGet {
+		// request is: get a sitemap page
+		h.sendPage(parts[2], parts[3], encoder, resp)
+		return
+	}
+
+	// fallback
+	resp.WriteHeader(http.StatusNotFound)
+}
+
+func (h *sitemapsHandler) sendSitemaps(encoder *json.Encoder, resp http.ResponseWriter) {
+	data := h.getSitemaps()
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *sitemapsHandler) sendPage(sitemapName, pageID string, encoder *json.Encoder, resp http.ResponseWriter) {
+	data, ok := h.getPage(sitemapName, pageID)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *sitemapsHandler) subscribe(encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	h.sitemapLocker.Lock()
+	h.subID++
+	subscriptionID := strconv.Itoa(h.subID)
+	h.subscriptions[subscriptionID] = true
+	h.sitemapLocker.Unlock()
+
+	location := "http://" + req.Host + "/rest/sitemaps/events/" + subscriptionID
+	data := api.SitemapSubscription{Status: "CREATED"}
+	data.Context.Headers.Location = []string{location}
+
+	resp.Header().Set("Location", location)
+	resp.WriteHeader(http.StatusCreated)
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+	}
+}
+
+func (h *sitemapsHandler) sendEvents(subscriptionID string, resp http.ResponseWriter, req *http.Request) {
+	h.sitemapLocker.Lock()
+	found := h.subscriptions[subscriptionID]
+	h.sitemapLocker.Unlock()
+
+	query := req.URL.Query()
+	if !found || query.Get("sitemap") == "" || query.Get("pageid") == "" {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	resp.Header().Add("Content-Type", "text/event-stream")
+	resp.WriteHeader(http.StatusOK)
+	if flusher, ok := resp.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	subID := h.eventBus.Subscribe(sitemapTopic(query.Get("sitemap"), query.Get("pageid")), func(message string) {
+		var err error
+		_, err = resp.Write(sitemapStreamPrefix)
+		h.err = errors.Join(h.err, err)
+		_, err = resp.Write([]byte(message))
+		h.err = errors.Join(h.err, err)
+		_, err = resp.Write(sitemapStreamSuffix)
+		h.err = errors.Join(h.err, err)
+
+		if flusher, ok := resp.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	})
+	defer h.eventBus.Unsubscribe(subID)
+
+	select {
+	case <-h.done:
+	case <-req.Context().Done():
+	}
+}
+
+// publish sends the event to the subscriptions of the sitemap page
+func (h *sitemapsHandler) publish(ev api.SitemapEvent) error {
+	if ev.SitemapName == "" || ev.PageID == "" {
+		return errors.New("missing sitemap name or page ID")
+	}
+	message, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	h.eventBus.Publish(sitemapTopic(ev.SitemapName, ev.PageID), string(message))
+	return nil
+}
+
+// setSitemap adds the new sitemap with its pages, or replaces the existing one (with the same name)
+func (h *sitemapsHandler) setSitemap(sitemap api.Sitemap, pages []api.SitemapPage) error {
+	if sitemap.Name == "" {
+		return errors.New("missing sitemap name")
+	}
+	h.sitemapLocker.Lock()
+	defer h.sitemapLocker.Unlock()
+
+	h.sitemaps[sitemap.Name] = sitemap
+	h.pages[sitemap.Name] = make(map[string]api.SitemapPage, len(pages))
+	for _, page := range pages {
+		if page.ID == "" {
+			return errors.New("missing page ID in sitemap " + strconv.Quote(sitemap.Name))
+		}
+		h.pages[sitemap.Name][page.ID] = page
+	}
+	return nil
+}
+
+func (h *sitemapsHandler) getSitemaps() []api.Sitemap {
+	h.sitemapLocker.Lock()
+	defer h.sitemapLocker.Unlock()
+
+	all := make([]api.Sitemap, 0, len(h.sitemaps))
+	for _, sitemap := range h.sitemaps {
+		all = append(all, sitemap)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+	return all
+}
+
+func (h *sitemapsHandler) getPage(sitemapName, pageID string) (api.SitemapPage, bool) {
+	h.sitemapLocker.Lock()
+	defer h.sitemapLocker.Unlock()
+
+	page, ok := h.pages[sitemapName][pageID]
+	return page, ok
+}
+
+func sitemapTopic(sitemapName, pageID string) string {
+	return sitemapName + "/" + pageID
+}
@@ -0,0 +1,260 @@
+package openhabtest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/creativeprojects/gopenhab/api"
+	"github.com/creativeprojects/gopenhab/event"
+)
+
+type thingsHandler struct {
+	log          Logger
+	things       map[string]api.Thing
+	thingsLocker sync.Mutex
+	eventBus     *eventBus
+	version      Version
+	err          error
+}
+
+func newThingsHandler(log Logger, bus *eventBus, version Version) *thingsHandler {
+	return &thingsHandler{
+		log:      log,
+		things:   make(map[string]api.Thing, 10),
+		eventBus: bus,
+		version:  version,
+	}
+}
+
+func (h *thingsHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	encoder := json.NewEncoder(resp)
+
+	if len(parts) == 2 && req.Method == http.MethodGet {
+		// request is: get all things
+		h.sendAllThings(encoder, resp)
+		return
+	}
+
+	if len(parts) == 3 {
+		if req.Method == http.MethodGet {
+			// request is: get single thing
+			h.sendThing(parts[2], encoder, resp)
+			return
+		}
+
+		if req.Method == http.MethodPut {
+			// request is: update thing
+			h.receiveThing(parts[2], encoder, resp, req)
+			return
+		}
+	}
+
+	if len(parts) == 4 && req.Method == http.MethodPut {
+		switch parts[3] {
+		case "enable":
+			// request is: enable or disable thing
+			h.receiveEnable(parts[2], encoder, resp, req)
+			return
+		case "config":
+			// request is: update thing configuration
+			h.receiveConfig(parts[2], encoder, resp, req)
+			return
+		}
+	}
+
+	// fallback
+	resp.WriteHeader(http.StatusNotFound)
+}
+
+func (h *thingsHandler) sendAllThings(encoder *json.Encoder, resp http.ResponseWriter) {
+	data := h.getThings()
+	err := encoder.Encode(&data)
+	if err != nil {
+		h.log.Logf("cannot encode data into JSON: %+v", data)
+		resp.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (h *thingsHandler) sendThing(uid string, encoder *json.Encoder, resp http.ResponseWriter) {
+	data, ok := h.getThing(uid)
+	if ok {
+		err := encoder.Encode(&data)
+		if err != nil {
+			h.log.Logf("cannot encode data into JSON: %+v", data)
+			resp.WriteHeader(http.StatusBadRequest)
+		}
+		return
+	}
+	// thing not found
+	resp.WriteHeader(http.StatusNotFound)
+}
+
+func (h *thingsHandler) receiveThing(uid string, encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	thing, ok := h.getThing(uid)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if !thing.Editable {
+		resp.WriteHeader(http.StatusConflict)
+		return
+	}
+	update := make(map[string]any)
+	err := json.NewDecoder(req.Body).Decode(&update)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	oldThing := thing
+	if label, ok := update["label"].(string); ok {
+		thing.Label = label
+	}
+	h.err = errors.Join(h.err, h.setThing(thing))
+	h.sendUpdatedThing(oldThing, thing, encoder)
+}
+
+func (h *thingsHandler) receiveEnable(uid string, encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	thing, ok := h.getThing(uid)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	enabled, err := strconv.ParseBool(string(body))
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	oldStatus := thing.StatusInfo
+	if enabled {
+		thing.StatusInfo = api.ThingStatusInfo{Status: "ONLINE", StatusDetail: "NONE"}
+	} else {
+		thing.StatusInfo = api.ThingStatusInfo{Status: "UNINITIALIZED", StatusDetail: "DISABLED"}
+	}
+	h.err = errors.Join(h.err, h.setThing(thing))
+	h.err = errors.Join(h.err, encoder.Encode(&thing))
+
+	if h.eventBus == nil {
+		return
+	}
+	// now send the events to the bus
+	newStatus := event.ThingStatus{Status: thing.StatusInfo.Status, StatusDetail: thing.StatusInfo.StatusDetail}
+	topic, ev := EventString(event.NewThingStatusInfoEvent(uid, newStatus), topicPrefix(h.version))
+	h.eventBus.Publish(topic, ev)
+	if oldStatus != thing.StatusInfo {
+		topic, ev = EventString(event.NewThingStatusInfoChangedEvent(uid,
+			event.ThingStatus{Status: oldStatus.Status, StatusDetail: oldStatus.StatusDetail, Description: oldStatus.Description},
+			newStatus,
+		), topicPrefix(h.version))
+		h.eventBus.Publish(topic, ev)
+	}
+}
+
+func (h *thingsHandler) receiveConfig(uid string, encoder *json.Encoder, resp http.ResponseWriter, req *http.Request) {
+	thing, ok := h.getThing(uid)
+	if !ok {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if !thing.Editable {
+		resp.WriteHeader(http.StatusConflict)
+		return
+	}
+	configuration := make(map[string]any)
+	err := json.NewDecoder(req.Body).Decode(&configuration)
+	if err != nil {
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	oldThing := thing
+	thing.Configuration = maps.Clone(thing.Configuration)
+	if thing.Configuration == nil {
+		thing.Configuration = make(map[string]any, len(configuration))
+	}
+	maps.Copy(thing.Configuration, configuration)
+	h.err = errors.Join(h.err, h.setThing(thing))
+	h.sendUpdatedThing(oldThing, thing, encoder)
+}
+
+// sendUpdatedThing sends the updated thing back to the client, and a ThingUpdated event to the event bus
+func (h *thingsHandler) sendUpdatedThing(oldThing, thing api.Thing, encoder *json.Encoder) {
+	h.err = errors.Join(h.err, encoder.Encode(&thing))
+
+	if h.eventBus == nil {
+		return
+	}
+	topic, ev := EventString(event.NewThingUpdated(eventThing(oldThing), eventThing(thing)), topicPrefix(h.version))
+	h.eventBus.Publish(topic, ev)
+}
+
+// setThing adds the new thing, or replaces the existing one (with the same UID)
+func (h *thingsHandler) setThing(thing api.Thing) error {
+	if thing.UID == "" {
+		return errors.New("missing thing UID")
+	}
+	h.thingsLocker.Lock()
+	defer h.thingsLocker.Unlock()
+
+	if thing.Configuration == nil {
+		thing.Configuration = map[string]any{}
+	}
+	if thing.Properties == nil {
+		thing.Properties = map[string]string{}
+	}
+	thing.Editable = true
+	h.things[thing.UID] = thing
+	return nil
+}
+
+// removeThing removes an existing thing. It doesn't return an error if the thing doesn't exist.
+func (h *thingsHandler) removeThing(uid string) error {
+	if uid == "" {
+		return errors.New("missing thing UID")
+	}
+	h.thingsLocker.Lock()
+	defer h.thingsLocker.Unlock()
+
+	delete(h.things, uid)
+	return nil
+}
+
+func (h *thingsHandler) getThings() []api.Thing {
+	h.thingsLocker.Lock()
+	defer h.thingsLocker.Unlock()
+
+	all := make([]api.Thing, 0, len(h.things))
+	for _, thing := range h.things {
+		all = append(all, thing)
+	}
+	return all
+}
+
+func (h *thingsHandler) getThing(uid string) (api.Thing, bool) {
+	h.thingsLocker.Lock()
+	defer h.thingsLocker.Unlock()
+
+	thing, ok := h.things[uid]
+	return thing, ok
+}
+
+func eventThing(thing api.Thing) event.Thing {
+	return event.Thing{
+		UID:           thing.UID,
+		Label:         thing.Label,
+		BridgeUID:     thing.BridgeUID,
+		Configuration: thing.Configuration,
+		Properties:    thing.Properties,
+		ThingTypeUID:  thing.ThingTypeUID,
+	}
+}